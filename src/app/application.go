@@ -1,11 +1,18 @@
 package app
 
 import (
+	"context"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
 	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/application"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/container"
+	log "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-logger"
 )
 
 type Application struct {
 	application.APIApplication
+	stopBackgroundJobs func()
 }
 
 func (r *Application) Init() {
@@ -17,4 +24,39 @@ func (r *Application) Init() {
 	r.RegisterRoutes(new(Routes))
 
 	r.Build()
+
+	r.startBackgroundJobs()
+}
+
+// Shutdown stops the background jobs and closes the mirror, waiting for both,
+// once the server has stopped serving.
+func (r *Application) Shutdown() {
+	if r.stopBackgroundJobs != nil {
+		r.stopBackgroundJobs()
+	}
+}
+
+// startBackgroundJobs runs the jobs that live as long as the server, until
+// Shutdown.
+func (r *Application) startBackgroundJobs() {
+	ctx := context.Background()
+
+	syncer := container.Provide[mirror.ISyncer]()
+	syncer.Start(ctx)
+
+	warmer := container.Provide[services.ICacheWarmer]()
+	warmer.Start(ctx)
+
+	indexer := container.Provide[services.ISearchIndexer]()
+	indexer.Start(ctx)
+
+	r.stopBackgroundJobs = func() {
+		indexer.Stop()
+		warmer.Stop()
+		syncer.Stop()
+
+		if err := container.Provide[*mirror.Store]().Close(); err != nil {
+			log.Errorf("closing the mirror failed: %v", err)
+		}
+	}
 }
@@ -1,12 +1,15 @@
 package app
 
 import (
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/cache"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
 	http "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients/builders"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/negotiation"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
 	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/container"
-	"gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
 	"go.uber.org/dig"
 )
 
@@ -15,8 +18,41 @@ type ApplicationModule struct {
 }
 
 func (r *ApplicationModule) Configure() {
-	r.Bind(http.NewUserRequestBuilder, dig.As(new(rest.IRequestBuilder)))
-	r.Bind(clients.NewUserClient, dig.As(new(clients.IUserClient)))
+	r.Bind(http.NewUserRequestBuilder)
+	r.Bind(clients.NewRateLimiter, dig.As(new(clients.IRateLimiter)))
+	r.Bind(http.NewRateLimitedRequestBuilder, dig.As(new(clients.IRequestBuilder)))
+	r.Bind(clients.NewBulkheads)
+	r.Bind(clients.NewHedges)
+	r.Bind(clients.NewUserClient)
+	r.Bind(cache.NewCache)
+	r.Bind(clients.NewCachedUserClient)
+	r.Bind(mirror.NewMirroring)
+	r.Bind(mirror.NewStore)
+	r.Bind(mirror.NewSyncer, dig.As(new(mirror.ISyncer)))
+	r.Bind(mirror.NewSource)
+	r.Bind(services.NewConcurrency)
 	r.Bind(services.NewUserService, dig.As(new(services.IUsersService)))
+	r.Bind(negotiation.NewJSONSerializer, dig.Group("serializers"), dig.As(new(negotiation.ISerializer)))
+	r.Bind(negotiation.NewXMLSerializer, dig.Group("serializers"), dig.As(new(negotiation.ISerializer)))
+	r.Bind(negotiation.NewMsgPackSerializer, dig.Group("serializers"), dig.As(new(negotiation.ISerializer)))
+	r.Bind(negotiation.NewCSVSerializer, dig.Group("serializers"), dig.As(new(negotiation.ISerializer)))
+	r.Bind(negotiation.NewContentNegotiator, dig.As(new(negotiation.IContentNegotiator)))
+	r.Bind(caching.NewConditional, dig.As(new(caching.IConditional)))
+	r.Bind(controllers.NewResponder, dig.As(new(controllers.IResponder)))
+	r.Bind(services.NewAnalyticsService, dig.As(new(services.IAnalyticsService)))
+	r.Bind(services.NewFeedService, dig.As(new(services.IFeedService)))
+	r.Bind(services.NewCommentsService, dig.As(new(services.ICommentsService)))
+	r.Bind(services.NewSearchService, dig.As(new(services.ISearchService), new(services.ISearchIndexer)))
+	r.Bind(services.NewReportsService, dig.As(new(services.IReportsService)))
+	r.Bind(services.NewChangesService, dig.As(new(services.IChangesService)))
+	r.Bind(services.NewWarming)
+	r.Bind(services.NewCacheWarmer, dig.As(new(services.ICacheWarmer)))
 	r.Bind(controllers.NewUsersController, dig.As(new(controllers.IUsersController)))
+	r.Bind(controllers.NewAnalyticsController, dig.As(new(controllers.IAnalyticsController)))
+	r.Bind(controllers.NewFeedController, dig.As(new(controllers.IFeedController)))
+	r.Bind(controllers.NewCommentsController, dig.As(new(controllers.ICommentsController)))
+	r.Bind(controllers.NewSearchController, dig.As(new(controllers.ISearchController)))
+	r.Bind(controllers.NewReportsController, dig.As(new(controllers.IReportsController)))
+	r.Bind(controllers.NewDiagnosticsController, dig.As(new(controllers.IDiagnosticsController)))
+	r.Bind(controllers.NewChangesController, dig.As(new(controllers.IChangesController)))
 }
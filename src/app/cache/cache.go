@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/go-redis/v9"
+	"github.com/vmihailenco/msgpack/v5"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+var backendErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "gorest_api",
+	Subsystem: "cache",
+	Name:      "backend_errors_total",
+	Help:      "Cache backend operations that failed, by backend and operation.",
+}, []string{"backend", "operation"})
+
+// ICache stores serialized values for a TTL, a missing or expired key not
+// being an error.
+type ICache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// NewCache builds the backend named by cache.backend.
+func NewCache() (ICache, error) {
+	switch backend := config.TryString("cache.backend", BackendMemory); backend {
+	case BackendMemory:
+		return NewMemory(config.TryInt("cache.memory.max-entries", 10_000)), nil
+	case BackendRedis:
+		client := redis.NewClient(&redis.Options{
+			Addr:     config.TryString("cache.redis.address", "localhost:6379"),
+			Password: config.TryString("cache.redis.password", ""),
+			DB:       config.TryInt("cache.redis.db", 0),
+		})
+
+		return NewRedis(client, config.TryString("cache.redis.key-prefix", "gorest-api:")), nil
+	default:
+		return nil, fmt.Errorf("cache.backend must be one of %s or %s, got %q", BackendMemory, BackendRedis, backend)
+	}
+}
+
+// Marshal serializes a value for the cache by its json field names, as the
+// model types are.
+func Marshal(value any) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	encoder := msgpack.NewEncoder(&buffer)
+	encoder.SetCustomStructTag("json")
+
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func Unmarshal(data []byte, value any) error {
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	decoder.SetCustomStructTag("json")
+
+	return decoder.Decode(value)
+}
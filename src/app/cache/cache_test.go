@@ -0,0 +1,141 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/cache"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+)
+
+func newRedis(t *testing.T) (*cache.Redis, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return cache.NewRedis(client, "test:"), server
+}
+
+func TestCache(t *testing.T) {
+	redisCache, _ := newRedis(t)
+
+	for name, backend := range map[string]cache.ICache{
+		cache.BackendMemory: cache.NewMemory(10),
+		cache.BackendRedis:  redisCache,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			_, found, err := backend.Get(ctx, "users/1")
+			require.NoError(t, err)
+			assert.False(t, found)
+
+			require.NoError(t, backend.Set(ctx, "users/1", []byte("user1"), time.Minute))
+
+			actual, found, err := backend.Get(ctx, "users/1")
+			require.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, []byte("user1"), actual)
+
+			require.NoError(t, backend.Delete(ctx, "users/1"))
+
+			_, found, err = backend.Get(ctx, "users/1")
+			require.NoError(t, err)
+			assert.False(t, found)
+		})
+	}
+}
+
+func TestMemory_Expiry(t *testing.T) {
+	memory := cache.NewMemory(10)
+	ctx := context.Background()
+
+	require.NoError(t, memory.Set(ctx, "users/1", []byte("user1"), 10*time.Millisecond))
+
+	assert.Eventually(t, func() bool {
+		_, found, err := memory.Get(ctx, "users/1")
+		return err == nil && !found
+	}, time.Second, time.Millisecond)
+}
+
+func TestMemory_MaxEntries(t *testing.T) {
+	memory := cache.NewMemory(2)
+	ctx := context.Background()
+
+	require.NoError(t, memory.Set(ctx, "users/1", []byte("user1"), time.Minute))
+	require.NoError(t, memory.Set(ctx, "users/2", []byte("user2"), time.Minute))
+	require.NoError(t, memory.Set(ctx, "users/2", []byte("user2"), time.Minute))
+
+	_, found, _ := memory.Get(ctx, "users/1")
+	assert.True(t, found)
+
+	require.NoError(t, memory.Set(ctx, "users/3", []byte("user3"), time.Minute))
+
+	_, found, _ = memory.Get(ctx, "users/1")
+	assert.False(t, found)
+	_, found, _ = memory.Get(ctx, "users/3")
+	assert.True(t, found)
+}
+
+func TestRedis_TTL(t *testing.T) {
+	redisCache, server := newRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, redisCache.Set(ctx, "posts/1", []byte("post1"), time.Minute))
+
+	assert.True(t, server.Exists("test:posts/1"))
+	assert.Equal(t, time.Minute, server.TTL("test:posts/1"))
+
+	server.FastForward(time.Minute)
+
+	_, found, err := redisCache.Get(ctx, "posts/1")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestRedis_Err(t *testing.T) {
+	redisCache, server := newRedis(t)
+	server.Close()
+
+	_, found, err := redisCache.Get(context.Background(), "posts/1")
+	require.Error(t, err)
+	assert.False(t, found)
+
+	require.Error(t, redisCache.Set(context.Background(), "posts/1", []byte("post1"), time.Minute))
+}
+
+func TestMarshal(t *testing.T) {
+	expected := &paging.PagedResultResponse[model.TodoResponse]{
+		Limit: 10,
+		Page:  1,
+		Pages: 3,
+		Total: 21,
+		Results: []model.TodoResponse{{
+			ID:     1,
+			UserID: 2,
+			Title:  "todo1",
+			DueOn:  time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			Status: model.TodoStatusPending,
+		}},
+	}
+
+	data, err := cache.Marshal(expected)
+	require.NoError(t, err)
+
+	actual := new(paging.PagedResultResponse[model.TodoResponse])
+	require.NoError(t, cache.Unmarshal(data, actual))
+
+	assert.Equal(t, expected.Total, actual.Total)
+	require.Len(t, actual.Results, 1)
+	assert.True(t, expected.Results[0].DueOn.Equal(actual.Results[0].DueOn))
+	assert.Equal(t, expected.Results[0].Title, actual.Results[0].Title)
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// Memory is a process-local cache holding at most maxEntries, dropping the
+// expired entries and then everything once full.
+type Memory struct {
+	maxEntries int
+	now        func() time.Time
+
+	mtx     sync.Mutex
+	entries map[string]memoryEntry
+}
+
+func NewMemory(maxEntries int) *Memory {
+	return &Memory{
+		maxEntries: max(maxEntries, 1),
+		now:        time.Now,
+		entries:    make(map[string]memoryEntry),
+	}
+}
+
+func (r *Memory) Get(_ context.Context, key string) ([]byte, bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	current, found := r.entries[key]
+	if !found {
+		return nil, false, nil
+	}
+
+	if !r.now().Before(current.expiresAt) {
+		delete(r.entries, key)
+		return nil, false, nil
+	}
+
+	return current.value, true, nil
+}
+
+func (r *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	now := r.now()
+
+	if _, found := r.entries[key]; !found && len(r.entries) >= r.maxEntries {
+		for k, current := range r.entries {
+			if !now.Before(current.expiresAt) {
+				delete(r.entries, k)
+			}
+		}
+		if len(r.entries) >= r.maxEntries {
+			clear(r.entries)
+		}
+	}
+
+	r.entries[key] = memoryEntry{
+		value:     value,
+		expiresAt: now.Add(ttl),
+	}
+
+	return nil
+}
+
+func (r *Memory) Delete(_ context.Context, key string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.entries, key)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
+)
+
+// RateLimitedRequestBuilder binds every GET to its context and feeds the
+// headers of the response back into the limiter the UserClient waits on.
+type RateLimitedRequestBuilder struct {
+	rb        *rest.RequestBuilder
+	transport http.RoundTripper
+	limiter   clients.IRateLimiter
+}
+
+func NewRateLimitedRequestBuilder(rb *rest.RequestBuilder, limiter clients.IRateLimiter) *RateLimitedRequestBuilder {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{Timeout: rb.ConnectTimeout}).DialContext
+	if rb.CustomPool != nil {
+		transport.MaxIdleConnsPerHost = rb.CustomPool.MaxIdleConnsPerHost
+	}
+
+	return &RateLimitedRequestBuilder{
+		rb:        rb,
+		transport: transport,
+		limiter:   limiter,
+	}
+}
+
+// Get issues the request through a builder of its own, whose transport binds
+// it to ctx and the builder timeout, since the rest client takes no context.
+// The connections are still pooled by the shared transport.
+func (r *RateLimitedRequestBuilder) Get(ctx context.Context, url string) *rest.Response {
+	if r.rb.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.rb.Timeout)
+		defer cancel()
+	}
+
+	rb := &rest.RequestBuilder{
+		Name:           r.rb.Name,
+		BaseURL:        r.rb.BaseURL,
+		Timeout:        r.rb.Timeout,
+		ConnectTimeout: r.rb.ConnectTimeout,
+		CustomPool: &rest.CustomPool{
+			Transport: &contextTransport{ctx: ctx, next: r.transport},
+		},
+	}
+
+	response := rb.Get(url)
+	if response.Err == nil && response.Response != nil {
+		r.limiter.Update(response.StatusCode, response.Header)
+	}
+
+	return response
+}
+
+// contextTransport replaces the context of every request with ctx. The rest
+// client reads the whole body before Get returns, so ctx outlives it.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
+)
+
+func newTestBuilder(t *testing.T, handler http.HandlerFunc, limiter *mocks.MockIRateLimiter) *RateLimitedRequestBuilder {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewRateLimitedRequestBuilder(&rest.RequestBuilder{BaseURL: server.URL, Timeout: time.Second}, limiter)
+}
+
+func TestRateLimitedRequestBuilder_Get(t *testing.T) {
+	limiter := mocks.NewMockIRateLimiter(t)
+	limiter.EXPECT().Update(http.StatusOK, mock.Anything).Return()
+
+	rb := newTestBuilder(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/1", r.URL.Path)
+		w.Header().Set("X-RateLimit-Remaining", "10")
+	}, limiter)
+
+	response := rb.Get(context.Background(), "/users/1")
+
+	require.NoError(t, response.Err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestRateLimitedRequestBuilder_Get_Canceled(t *testing.T) {
+	aborted := make(chan struct{})
+
+	rb := newTestBuilder(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		close(aborted)
+	}, mocks.NewMockIRateLimiter(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	response := rb.Get(ctx, "/users/1")
+
+	require.ErrorIs(t, response.Err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Fatal("the upstream request was not aborted")
+	}
+}
@@ -0,0 +1,255 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+const (
+	ResourceUsers    = "users"
+	ResourcePosts    = "posts"
+	ResourceTodos    = "todos"
+	ResourceComments = "comments"
+)
+
+var ErrBulkheadTimeout = errors.New("timed out waiting for an upstream slot")
+
+var (
+	bulkheadWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_bulkhead",
+		Name:      "wait_seconds",
+		Help:      "Time spent waiting for an upstream slot, by resource.",
+		Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"resource"})
+	bulkheadInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_bulkhead",
+		Name:      "in_flight",
+		Help:      "Upstream requests currently in flight, by resource.",
+	}, []string{"resource"})
+	bulkheadQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_bulkhead",
+		Name:      "queued",
+		Help:      "Upstream requests waiting for a slot, by resource.",
+	}, []string{"resource"})
+	bulkheadSaturation = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_bulkhead",
+		Name:      "saturation",
+		Help:      "Ratio of in-flight upstream requests to the resource limit.",
+	}, []string{"resource"})
+	bulkheadRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_bulkhead",
+		Name:      "rejections_total",
+		Help:      "Upstream requests given up while waiting for a slot, by resource and reason.",
+	}, []string{"resource", "reason"})
+)
+
+type callerKey struct{}
+
+var callers atomic.Uint64
+
+// WithCaller tags ctx with a new caller, upstream slots being shared fairly
+// between callers rather than between individual requests.
+func WithCaller(ctx context.Context) context.Context {
+	return context.WithValue(ctx, callerKey{}, callers.Add(1))
+}
+
+func caller(ctx context.Context) uint64 {
+	id, _ := ctx.Value(callerKey{}).(uint64)
+	return id
+}
+
+type waiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// Bulkhead limits the upstream requests in flight for a resource. Waiters are
+// queued per caller and served round-robin between callers, so one request
+// fanning out hundreds of calls cannot starve the others.
+type Bulkhead struct {
+	resource     string
+	limit        int
+	queueTimeout time.Duration
+
+	mtx      sync.Mutex
+	inFlight int
+	queued   int
+	queues   map[uint64][]*waiter
+	ring     []uint64
+	next     int
+}
+
+func NewBulkhead(resource string, limit int, queueTimeout time.Duration) *Bulkhead {
+	return &Bulkhead{
+		resource:     resource,
+		limit:        max(limit, 1),
+		queueTimeout: queueTimeout,
+		queues:       make(map[uint64][]*waiter),
+	}
+}
+
+func (r *Bulkhead) Acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+
+	r.mtx.Lock()
+	if r.inFlight < r.limit && r.queued == 0 {
+		r.inFlight++
+		r.report()
+		r.mtx.Unlock()
+
+		bulkheadWait.WithLabelValues(r.resource).Observe(0)
+		return sync.OnceFunc(r.release), nil
+	}
+
+	w := &waiter{ready: make(chan struct{})}
+	r.enqueue(caller(ctx), w)
+	r.report()
+	r.mtx.Unlock()
+
+	timer := time.NewTimer(r.queueTimeout)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-w.ready:
+		bulkheadWait.WithLabelValues(r.resource).Observe(time.Since(start).Seconds())
+		return sync.OnceFunc(r.release), nil
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-timer.C:
+		err = fmt.Errorf("%w: %s after %s", ErrBulkheadTimeout, r.resource, r.queueTimeout)
+	}
+
+	r.mtx.Lock()
+	granted := w.granted
+	if !granted {
+		r.dequeue(caller(ctx), w)
+		r.report()
+	}
+	r.mtx.Unlock()
+
+	if granted {
+		r.release()
+	}
+
+	reason := "timeout"
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		reason = "canceled"
+	}
+	bulkheadRejections.WithLabelValues(r.resource, reason).Inc()
+	bulkheadWait.WithLabelValues(r.resource).Observe(time.Since(start).Seconds())
+
+	return nil, err
+}
+
+func (r *Bulkhead) release() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.inFlight--
+	for r.inFlight < r.limit && r.queued > 0 {
+		w := r.pop()
+		w.granted = true
+		r.inFlight++
+		close(w.ready)
+	}
+	r.report()
+}
+
+func (r *Bulkhead) enqueue(id uint64, w *waiter) {
+	if len(r.queues[id]) == 0 {
+		r.ring = append(r.ring, id)
+	}
+	r.queues[id] = append(r.queues[id], w)
+	r.queued++
+}
+
+func (r *Bulkhead) dequeue(id uint64, w *waiter) {
+	queue := r.queues[id]
+	for i := 0; i < len(queue); i++ {
+		if queue[i] == w {
+			r.queues[id] = append(queue[:i], queue[i+1:]...)
+			r.queued--
+			break
+		}
+	}
+
+	if len(r.queues[id]) == 0 {
+		r.drop(id)
+	}
+}
+
+func (r *Bulkhead) pop() *waiter {
+	if r.next >= len(r.ring) {
+		r.next = 0
+	}
+
+	id := r.ring[r.next]
+	w := r.queues[id][0]
+	r.queues[id] = r.queues[id][1:]
+	r.queued--
+
+	if len(r.queues[id]) == 0 {
+		r.drop(id)
+	} else {
+		r.next++
+	}
+
+	return w
+}
+
+func (r *Bulkhead) drop(id uint64) {
+	delete(r.queues, id)
+
+	for i := 0; i < len(r.ring); i++ {
+		if r.ring[i] == id {
+			r.ring = append(r.ring[:i], r.ring[i+1:]...)
+			if i < r.next {
+				r.next--
+			}
+			return
+		}
+	}
+}
+
+func (r *Bulkhead) report() {
+	bulkheadInFlight.WithLabelValues(r.resource).Set(float64(r.inFlight))
+	bulkheadQueued.WithLabelValues(r.resource).Set(float64(r.queued))
+	bulkheadSaturation.WithLabelValues(r.resource).Set(float64(r.inFlight) / float64(r.limit))
+}
+
+// Bulkheads holds the process-wide bulkhead of every upstream resource.
+type Bulkheads map[string]*Bulkhead
+
+func NewBulkheads() Bulkheads {
+	queueTimeout := time.Duration(config.TryInt("bulkhead.queue-timeout", 2000)) * time.Millisecond
+
+	bulkheads := make(Bulkheads)
+	for _, resource := range []string{ResourceUsers, ResourcePosts, ResourceTodos, ResourceComments} {
+		bulkheads[resource] = NewBulkhead(resource, config.TryInt("bulkhead."+resource+".max-in-flight", 10), queueTimeout)
+	}
+
+	return bulkheads
+}
+
+func (r Bulkheads) Acquire(ctx context.Context, resource string) (func(), error) {
+	bulkhead, ok := r[resource]
+	if !ok {
+		return func() {}, nil
+	}
+
+	return bulkhead.Acquire(ctx)
+}
@@ -0,0 +1,145 @@
+package clients
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkhead_Limit(t *testing.T) {
+	bulkhead := NewBulkhead("test-limit", 2, time.Second)
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			release, err := bulkhead.Acquire(WithCaller(context.Background()))
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer release()
+
+			current := running.Add(1)
+			for {
+				p := peak.Load()
+				if current <= p || peak.CompareAndSwap(p, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+	assert.Zero(t, bulkhead.inFlight)
+	assert.Zero(t, bulkhead.queued)
+}
+
+func TestBulkhead_QueueTimeout(t *testing.T) {
+	bulkhead := NewBulkhead("test-timeout", 1, 10*time.Millisecond)
+
+	release, err := bulkhead.Acquire(context.Background())
+	require.NoError(t, err)
+
+	_, err = bulkhead.Acquire(context.Background())
+	require.ErrorIs(t, err, ErrBulkheadTimeout)
+
+	release()
+	release()
+
+	assert.Zero(t, bulkhead.inFlight)
+	assert.Zero(t, bulkhead.queued)
+}
+
+func TestBulkhead_Canceled(t *testing.T) {
+	bulkhead := NewBulkhead("test-canceled", 1, time.Second)
+
+	release, err := bulkhead.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = bulkhead.Acquire(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, bulkhead.queued)
+}
+
+func TestBulkhead_Fairness(t *testing.T) {
+	bulkhead := NewBulkhead("test-fairness", 1, time.Second)
+
+	release, err := bulkhead.Acquire(context.Background())
+	require.NoError(t, err)
+
+	var (
+		mtx    sync.Mutex
+		grants []string
+		wg     sync.WaitGroup
+	)
+
+	enqueue := func(ctx context.Context, name string) {
+		bulkhead.mtx.Lock()
+		queued := bulkhead.queued
+		bulkhead.mtx.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			release, err := bulkhead.Acquire(ctx)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			mtx.Lock()
+			grants = append(grants, name)
+			mtx.Unlock()
+
+			release()
+		}()
+
+		require.Eventually(t, func() bool {
+			bulkhead.mtx.Lock()
+			defer bulkhead.mtx.Unlock()
+			return bulkhead.queued == queued+1
+		}, time.Second, time.Millisecond)
+	}
+
+	callerA, callerB := WithCaller(context.Background()), WithCaller(context.Background())
+
+	enqueue(callerA, "a1")
+	enqueue(callerA, "a2")
+	enqueue(callerA, "a3")
+	enqueue(callerB, "b1")
+	enqueue(callerB, "b2")
+
+	release()
+	wg.Wait()
+
+	assert.Equal(t, []string{"a1", "b1", "a2", "b2", "a3"}, grants)
+}
+
+func TestBulkheads_Acquire(t *testing.T) {
+	bulkheads := Bulkheads{ResourceUsers: NewBulkhead("test-users", 1, time.Second)}
+
+	release, err := bulkheads.Acquire(context.Background(), ResourceUsers)
+	require.NoError(t, err)
+	assert.Equal(t, 1, bulkheads[ResourceUsers].inFlight)
+	release()
+
+	release, err = bulkheads.Acquire(context.Background(), "unknown")
+	require.NoError(t, err)
+	release()
+}
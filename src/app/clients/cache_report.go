@@ -0,0 +1,81 @@
+package clients
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	CacheHit          = "HIT"
+	CacheMiss         = "MISS"
+	CacheStale        = "STALE"
+	CacheStaleIfError = "STALE-IF-ERROR"
+)
+
+type cacheReportKey struct{}
+
+// CacheReport tells how the upstream data behind a response was served, the
+// response being as stale as the stalest piece of it.
+type CacheReport struct {
+	mtx    sync.Mutex
+	status string
+}
+
+func WithCacheReport(ctx context.Context) (context.Context, *CacheReport) {
+	report := new(CacheReport)
+	return context.WithValue(ctx, cacheReportKey{}, report), report
+}
+
+func cacheReportFrom(ctx context.Context) *CacheReport {
+	report, _ := ctx.Value(cacheReportKey{}).(*CacheReport)
+	return report
+}
+
+func (r *CacheReport) record(status string) {
+	if r == nil {
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if severity(status) > severity(r.status) {
+		r.status = status
+	}
+}
+
+// Status is the X-Cache-Status of the response, empty when no upstream data
+// went through the cache.
+func (r *CacheReport) Status() string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.status
+}
+
+// Warning is the Warning header of a response built from stale data.
+func (r *CacheReport) Warning() string {
+	switch r.Status() {
+	case CacheStale:
+		return `110 - "Response is Stale"`
+	case CacheStaleIfError:
+		return `111 - "Revalidation Failed"`
+	default:
+		return ""
+	}
+}
+
+func severity(status string) int {
+	switch status {
+	case CacheHit:
+		return 1
+	case CacheMiss:
+		return 2
+	case CacheStale:
+		return 3
+	case CacheStaleIfError:
+		return 4
+	default:
+		return 0
+	}
+}
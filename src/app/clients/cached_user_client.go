@@ -0,0 +1,292 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/cache"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	log "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-logger"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+var upstreamCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "gorest_api",
+	Subsystem: "upstream_cache",
+	Name:      "requests_total",
+	Help:      "Upstream reads served through the cache, by resource and cache status.",
+}, []string{"resource", "status"})
+
+type refreshAheadKey struct{}
+
+// WithRefreshAhead makes the reads done with ctx refetch the cached entries that
+// are no longer fresh, or will not be within ahead, storing whatever the
+// upstream returns. The entries fresh for longer are served as usual.
+func WithRefreshAhead(ctx context.Context, ahead time.Duration) context.Context {
+	return context.WithValue(ctx, refreshAheadKey{}, ahead)
+}
+
+func expiring(ctx context.Context, age time.Duration, policy CachePolicy) bool {
+	ahead, found := ctx.Value(refreshAheadKey{}).(time.Duration)
+	return found && age >= policy.MaxAge-ahead
+}
+
+// CachePolicy is how long the upstream data of a resource is served fresh, and
+// then stale while revalidating or on upstream errors.
+type CachePolicy struct {
+	MaxAge               time.Duration
+	StaleWhileRevalidate time.Duration
+	StaleIfError         time.Duration
+}
+
+// ttl is how long an entry is worth keeping in the cache.
+func (r CachePolicy) ttl() time.Duration {
+	return r.MaxAge + max(r.StaleWhileRevalidate, r.StaleIfError)
+}
+
+// NewCachePolicies reads the upstream-cache.<resource>.* policy of every
+// resource, falling back to the upstream-cache.* defaults.
+func NewCachePolicies() map[string]CachePolicy {
+	defaults := CachePolicy{
+		MaxAge:               time.Duration(config.TryInt("upstream-cache.max-age", 5000)) * time.Millisecond,
+		StaleWhileRevalidate: time.Duration(config.TryInt("upstream-cache.stale-while-revalidate", 60000)) * time.Millisecond,
+		StaleIfError:         time.Duration(config.TryInt("upstream-cache.stale-if-error", 600000)) * time.Millisecond,
+	}
+
+	policies := make(map[string]CachePolicy)
+	for _, resource := range []string{ResourceUsers, ResourcePosts, ResourceTodos, ResourceComments} {
+		prefix := "upstream-cache." + resource + "."
+		policies[resource] = CachePolicy{
+			MaxAge:               time.Duration(config.TryInt(prefix+"max-age", int(defaults.MaxAge.Milliseconds()))) * time.Millisecond,
+			StaleWhileRevalidate: time.Duration(config.TryInt(prefix+"stale-while-revalidate", int(defaults.StaleWhileRevalidate.Milliseconds()))) * time.Millisecond,
+			StaleIfError:         time.Duration(config.TryInt(prefix+"stale-if-error", int(defaults.StaleIfError.Milliseconds()))) * time.Millisecond,
+		}
+	}
+
+	return policies
+}
+
+type entry[T any] struct {
+	StoredAt time.Time `json:"stored_at"`
+	Value    T         `json:"value"`
+}
+
+// CachedUserClient keeps upstream responses in the cache, serving them fresh
+// for max-age, stale while refreshing them in the background for
+// stale-while-revalidate after that, and stale for stale-if-error when the
+// upstream fails. A failing cache is only a miss.
+type CachedUserClient struct {
+	client   IUserClient
+	cache    cache.ICache
+	policies map[string]CachePolicy
+	now      func() time.Time
+
+	mtx        sync.Mutex
+	refreshing map[string]bool
+}
+
+func NewCachedUserClient(client *UserClient, backend cache.ICache) *CachedUserClient {
+	return newCachedUserClient(client, backend, NewCachePolicies())
+}
+
+func newCachedUserClient(client IUserClient, backend cache.ICache, policies map[string]CachePolicy) *CachedUserClient {
+	return &CachedUserClient{
+		client:     client,
+		cache:      backend,
+		policies:   policies,
+		now:        time.Now,
+		refreshing: make(map[string]bool),
+	}
+}
+
+func (c *CachedUserClient) GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error) {
+	return cached(ctx, c, ResourceUsers, fmt.Sprintf("users?page=%d&per_page=%d", page, perPage), func(ctx context.Context) (*paging.PagedResultResponse[model.UserResponse], error) {
+		return c.client.GetUsers(ctx, page, perPage)
+	})
+}
+
+func (c *CachedUserClient) GetUser(ctx context.Context, userID int) (*model.UserResponse, error) {
+	return cached(ctx, c, ResourceUsers, fmt.Sprintf("users/%d", userID), func(ctx context.Context) (*model.UserResponse, error) {
+		return c.client.GetUser(ctx, userID)
+	})
+}
+
+func (c *CachedUserClient) GetAllPosts(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.PostResponse], error) {
+	return cached(ctx, c, ResourcePosts, fmt.Sprintf("posts?page=%d&per_page=%d", page, perPage), func(ctx context.Context) (*paging.PagedResultResponse[model.PostResponse], error) {
+		return c.client.GetAllPosts(ctx, page, perPage)
+	})
+}
+
+func (c *CachedUserClient) GetPost(ctx context.Context, postID int) (*model.PostResponse, error) {
+	return cached(ctx, c, ResourcePosts, fmt.Sprintf("posts/%d", postID), func(ctx context.Context) (*model.PostResponse, error) {
+		return c.client.GetPost(ctx, postID)
+	})
+}
+
+func (c *CachedUserClient) GetAllComments(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	return cached(ctx, c, ResourceComments, fmt.Sprintf("comments?page=%d&per_page=%d", page, perPage), func(ctx context.Context) (*paging.PagedResultResponse[model.CommentResponse], error) {
+		return c.client.GetAllComments(ctx, page, perPage)
+	})
+}
+
+func (c *CachedUserClient) GetCommentsByEmail(ctx context.Context, email string, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	key := fmt.Sprintf("comments?email=%s&page=%d&per_page=%d", strings.ToLower(email), page, perPage)
+	return cached(ctx, c, ResourceComments, key, func(ctx context.Context) (*paging.PagedResultResponse[model.CommentResponse], error) {
+		return c.client.GetCommentsByEmail(ctx, email, page, perPage)
+	})
+}
+
+func (c *CachedUserClient) GetAllTodos(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.TodoResponse], error) {
+	return cached(ctx, c, ResourceTodos, fmt.Sprintf("todos?page=%d&per_page=%d", page, perPage), func(ctx context.Context) (*paging.PagedResultResponse[model.TodoResponse], error) {
+		return c.client.GetAllTodos(ctx, page, perPage)
+	})
+}
+
+func (c *CachedUserClient) GetComment(ctx context.Context, commentID int) (*model.CommentResponse, error) {
+	return cached(ctx, c, ResourceComments, fmt.Sprintf("comments/%d", commentID), func(ctx context.Context) (*model.CommentResponse, error) {
+		return c.client.GetComment(ctx, commentID)
+	})
+}
+
+func (c *CachedUserClient) GetTodo(ctx context.Context, todoID int) (*model.TodoResponse, error) {
+	return cached(ctx, c, ResourceTodos, fmt.Sprintf("todos/%d", todoID), func(ctx context.Context) (*model.TodoResponse, error) {
+		return c.client.GetTodo(ctx, todoID)
+	})
+}
+
+func (c *CachedUserClient) GetPosts(ctx context.Context, userID int) ([]model.PostResponse, error) {
+	return cached(ctx, c, ResourcePosts, fmt.Sprintf("users/%d/posts", userID), func(ctx context.Context) ([]model.PostResponse, error) {
+		return c.client.GetPosts(ctx, userID)
+	})
+}
+
+func (c *CachedUserClient) GetTodos(ctx context.Context, userID int) ([]model.TodoResponse, error) {
+	return cached(ctx, c, ResourceTodos, fmt.Sprintf("users/%d/todos", userID), func(ctx context.Context) ([]model.TodoResponse, error) {
+		return c.client.GetTodos(ctx, userID)
+	})
+}
+
+func (c *CachedUserClient) GetComments(ctx context.Context, postID int) ([]model.CommentResponse, error) {
+	return cached(ctx, c, ResourceComments, fmt.Sprintf("posts/%d/comments", postID), func(ctx context.Context) ([]model.CommentResponse, error) {
+		return c.client.GetComments(ctx, postID)
+	})
+}
+
+func cached[T any](ctx context.Context, c *CachedUserClient, resource string, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	report := cacheReportFrom(ctx)
+	policy := c.policies[resource]
+
+	current, found := lookup[T](ctx, c, key)
+
+	var age time.Duration
+	if found {
+		age = c.now().Sub(current.StoredAt)
+	}
+
+	if found && !expiring(ctx, age, policy) {
+		switch {
+		case age < policy.MaxAge:
+			c.served(report, resource, CacheHit)
+			return current.Value, nil
+		case age < policy.MaxAge+policy.StaleWhileRevalidate:
+			c.revalidate(ctx, resource, key, func(ctx context.Context) (any, error) {
+				return fetch(ctx)
+			})
+			c.served(report, resource, CacheStale)
+			return current.Value, nil
+		}
+	}
+
+	value, err := fetch(ctx)
+	switch {
+	case err == nil:
+		c.store(ctx, resource, key, value)
+		c.served(report, resource, CacheMiss)
+	case errors.Is(err, ErrNotFound):
+		c.evict(ctx, key)
+	case found && age < policy.MaxAge+policy.StaleIfError:
+		c.served(report, resource, CacheStaleIfError)
+		return current.Value, nil
+	}
+
+	return value, err
+}
+
+func lookup[T any](ctx context.Context, c *CachedUserClient, key string) (*entry[T], bool) {
+	data, found, err := c.cache.Get(ctx, key)
+	if err != nil {
+		log.Warnf("reading %s from the cache failed: %v", key, err)
+		return nil, false
+	}
+	if !found {
+		return nil, false
+	}
+
+	current := new(entry[T])
+	if err = cache.Unmarshal(data, current); err != nil {
+		log.Warnf("decoding %s from the cache failed: %v", key, err)
+		return nil, false
+	}
+
+	return current, true
+}
+
+// revalidate refreshes the entry in the background, at most once at a time
+// per replica, past the end of the request that found it stale.
+func (c *CachedUserClient) revalidate(ctx context.Context, resource string, key string, fetch func(ctx context.Context) (any, error)) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.refreshing[key] {
+		return
+	}
+	c.refreshing[key] = true
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+
+		value, err := fetch(ctx)
+		switch {
+		case err == nil:
+			c.store(ctx, resource, key, value)
+		case errors.Is(err, ErrNotFound):
+			c.evict(ctx, key)
+		default:
+			log.Warnf("revalidating %s %s failed: %v", resource, key, err)
+		}
+
+		c.mtx.Lock()
+		delete(c.refreshing, key)
+		c.mtx.Unlock()
+	}()
+}
+
+func (c *CachedUserClient) store(ctx context.Context, resource string, key string, value any) {
+	data, err := cache.Marshal(entry[any]{StoredAt: c.now(), Value: value})
+	if err != nil {
+		log.Warnf("encoding %s for the cache failed: %v", key, err)
+		return
+	}
+
+	if err = c.cache.Set(ctx, key, data, c.policies[resource].ttl()); err != nil {
+		log.Warnf("writing %s to the cache failed: %v", key, err)
+	}
+}
+
+func (c *CachedUserClient) evict(ctx context.Context, key string) {
+	if err := c.cache.Delete(ctx, key); err != nil {
+		log.Warnf("deleting %s from the cache failed: %v", key, err)
+	}
+}
+
+func (c *CachedUserClient) served(report *CacheReport, resource string, status string) {
+	report.record(status)
+	upstreamCacheRequests.WithLabelValues(resource, strings.ToLower(status)).Inc()
+}
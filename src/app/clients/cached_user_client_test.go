@@ -0,0 +1,198 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/cache"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+)
+
+var errUpstream = errors.New("upstream unavailable")
+
+type clock struct {
+	mtx sync.Mutex
+	now time.Time
+}
+
+func (r *clock) Now() time.Time {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.now
+}
+
+func (r *clock) Add(d time.Duration) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.now = r.now.Add(d)
+}
+
+func newTestCachedUserClient(userClient IUserClient, now *clock) *CachedUserClient {
+	policy := CachePolicy{MaxAge: time.Second, StaleWhileRevalidate: 10 * time.Second, StaleIfError: time.Minute}
+
+	client := newCachedUserClient(userClient, cache.NewMemory(100), map[string]CachePolicy{
+		ResourceUsers:    policy,
+		ResourcePosts:    policy,
+		ResourceTodos:    policy,
+		ResourceComments: policy,
+	})
+	client.now = now.Now
+
+	return client
+}
+
+func TestCachedUserClient_Hit(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "user1"}, nil).Once()
+
+	client := newTestCachedUserClient(userClient, &clock{now: time.Now()})
+
+	ctx, report := WithCacheReport(context.Background())
+	actual, err := client.GetUser(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "user1", actual.Name)
+	assert.Equal(t, CacheMiss, report.Status())
+
+	ctx, report = WithCacheReport(context.Background())
+	actual, err = client.GetUser(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "user1", actual.Name)
+	assert.Equal(t, CacheHit, report.Status())
+	assert.Empty(t, report.Warning())
+}
+
+func TestCachedUserClient_RefreshAhead(t *testing.T) {
+	now := &clock{now: time.Now()}
+
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "old"}, nil).Once()
+
+	client := newTestCachedUserClient(userClient, now)
+	ctx := WithRefreshAhead(context.Background(), 500*time.Millisecond)
+
+	_, err := client.GetUser(context.Background(), 1)
+	require.NoError(t, err)
+
+	now.Add(100 * time.Millisecond)
+	actual, err := client.GetUser(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "old", actual.Name, "entries fresh for longer than ahead are kept")
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "new"}, nil).Once()
+
+	now.Add(500 * time.Millisecond)
+	actual, err = client.GetUser(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "new", actual.Name)
+
+	actual, err = client.GetUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "new", actual.Name)
+}
+
+func TestCachedUserClient_Stale(t *testing.T) {
+	now := &clock{now: time.Now()}
+
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, Title: "old"}}, nil).Once()
+
+	client := newTestCachedUserClient(userClient, now)
+
+	_, err := client.GetPosts(context.Background(), 1)
+	require.NoError(t, err)
+
+	refreshed := make(chan struct{})
+	userClient.EXPECT().GetPosts(mock.Anything, 1).RunAndReturn(func(context.Context, int) ([]model.PostResponse, error) {
+		defer close(refreshed)
+		return []model.PostResponse{{ID: 1, Title: "new"}}, nil
+	}).Once()
+
+	now.Add(5 * time.Second)
+
+	ctx, report := WithCacheReport(context.Background())
+	actual, err := client.GetPosts(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "old", actual[0].Title)
+	assert.Equal(t, CacheStale, report.Status())
+	assert.Equal(t, `110 - "Response is Stale"`, report.Warning())
+
+	<-refreshed
+	assert.Eventually(t, func() bool {
+		ctx, report := WithCacheReport(context.Background())
+		actual, err := client.GetPosts(ctx, 1)
+		return err == nil && actual[0].Title == "new" && report.Status() == CacheHit
+	}, time.Second, time.Millisecond)
+}
+
+func TestCachedUserClient_StaleIfError(t *testing.T) {
+	now := &clock{now: time.Now()}
+
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{{ID: 1}}, nil).Once()
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return(nil, errUpstream)
+
+	client := newTestCachedUserClient(userClient, now)
+
+	_, err := client.GetTodos(context.Background(), 1)
+	require.NoError(t, err)
+
+	now.Add(30 * time.Second)
+
+	ctx, report := WithCacheReport(context.Background())
+	actual, err := client.GetTodos(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, CacheStaleIfError, report.Status())
+	assert.Equal(t, `111 - "Revalidation Failed"`, report.Warning())
+
+	now.Add(time.Minute)
+
+	actual, err = client.GetTodos(context.Background(), 1)
+	require.ErrorIs(t, err, errUpstream)
+	assert.Nil(t, actual)
+}
+
+func TestCachedUserClient_NotFound(t *testing.T) {
+	now := &clock{now: time.Now()}
+
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetPost(mock.Anything, 1).Return(&model.PostResponse{ID: 1}, nil).Once()
+	userClient.EXPECT().GetPost(mock.Anything, 1).Return(nil, fmt.Errorf("%w: post 1", ErrNotFound)).Twice()
+
+	client := newTestCachedUserClient(userClient, now)
+
+	_, err := client.GetPost(context.Background(), 1)
+	require.NoError(t, err)
+
+	now.Add(30 * time.Second)
+
+	_, err = client.GetPost(context.Background(), 1)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = client.GetPost(context.Background(), 1)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCacheReport(t *testing.T) {
+	ctx, report := WithCacheReport(context.Background())
+
+	assert.Empty(t, report.Status())
+
+	for _, status := range []string{CacheHit, CacheStale, CacheMiss, CacheHit} {
+		cacheReportFrom(ctx).record(status)
+	}
+
+	assert.Equal(t, CacheStale, report.Status())
+
+	cacheReportFrom(context.Background()).record(CacheHit)
+}
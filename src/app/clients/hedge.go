@@ -0,0 +1,255 @@
+package clients
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+const (
+	hedgeSamples    = 200
+	hedgeMinSamples = 20
+	hedgeBurst      = 10
+)
+
+var (
+	hedgeRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_hedge",
+		Name:      "requests_total",
+		Help:      "Duplicate upstream requests issued because the first one was slow, by resource.",
+	}, []string{"resource"})
+	hedgeWins = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_hedge",
+		Name:      "wins_total",
+		Help:      "Duplicate upstream requests that answered before the first one, by resource.",
+	}, []string{"resource"})
+	hedgeCapped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_hedge",
+		Name:      "capped_total",
+		Help:      "Slow upstream requests not hedged because the hedging budget was spent or too many losers are still running, by resource.",
+	}, []string{"resource"})
+	hedgeDelay = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_hedge",
+		Name:      "delay_seconds",
+		Help:      "Delay after which a duplicate upstream request is issued, by resource.",
+	}, []string{"resource"})
+)
+
+type attempt struct {
+	response *rest.Response
+	err      error
+	hedged   bool
+	latency  time.Duration
+}
+
+// Hedge issues a duplicate of an upstream request still running after a delay,
+// fixed or the p95 of the recent latencies, and answers with whichever returns
+// first. Every request earns maxPercent/100 of a hedge, so no more than that
+// share of requests is ever duplicated, and no hedge is issued while hedgeBurst
+// losers are still running.
+type Hedge struct {
+	resource   string
+	delay      time.Duration
+	adaptive   bool
+	maxPercent int
+
+	mtx       sync.Mutex
+	budget    int
+	losers    int
+	latencies []time.Duration
+	next      int
+}
+
+func NewHedge(resource string, delay time.Duration, adaptive bool, maxPercent int) *Hedge {
+	hedge := &Hedge{
+		resource:   resource,
+		delay:      delay,
+		adaptive:   adaptive,
+		maxPercent: maxPercent,
+		latencies:  make([]time.Duration, 0, hedgeSamples),
+	}
+	hedgeDelay.WithLabelValues(resource).Set(delay.Seconds())
+
+	return hedge
+}
+
+// Do runs f and, once the delay is over, a second f. An error only wins when no
+// other attempt is left running. The context of the loser is cancelled, which
+// aborts its upstream request, and it counts as a loser until it returns.
+func (r *Hedge) Do(ctx context.Context, f func(ctx context.Context) (*rest.Response, error)) (*rest.Response, error) {
+	attemptCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	start := time.Now()
+
+	// pending and returned are guarded by r.mtx, so that attempts finishing
+	// after Do returned are no longer counted as losers.
+	var (
+		pending  int
+		returned bool
+	)
+	defer func() {
+		r.mtx.Lock()
+		returned = true
+		r.losers += pending
+		r.mtx.Unlock()
+	}()
+
+	attempts := make(chan attempt, 2)
+	run := func(hedged bool) {
+		response, err := f(attemptCtx)
+		latency := time.Since(start)
+
+		r.mtx.Lock()
+		pending--
+		if returned {
+			r.losers--
+		}
+		r.mtx.Unlock()
+
+		attempts <- attempt{response: response, err: err, hedged: hedged, latency: latency}
+	}
+	launch := func(hedged bool) {
+		r.mtx.Lock()
+		pending++
+		r.mtx.Unlock()
+
+		go run(hedged)
+	}
+
+	r.earn()
+	launch(false)
+
+	timer := time.NewTimer(r.currentDelay())
+	defer timer.Stop()
+
+	hedgeC, running := timer.C, 1
+
+	var firstErr error
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			if !r.spend() {
+				hedgeCapped.WithLabelValues(r.resource).Inc()
+				continue
+			}
+
+			hedgeRequests.WithLabelValues(r.resource).Inc()
+			running++
+			launch(true)
+		case result := <-attempts:
+			running--
+			if result.err == nil {
+				r.observe(result.latency)
+				if result.hedged {
+					hedgeWins.WithLabelValues(r.resource).Inc()
+				}
+				return result.response, nil
+			}
+
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			if running == 0 {
+				return nil, firstErr
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (r *Hedge) earn() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.budget = min(r.budget+r.maxPercent, hedgeBurst*100)
+}
+
+func (r *Hedge) spend() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.budget < 100 || r.losers >= hedgeBurst {
+		return false
+	}
+	r.budget -= 100
+
+	return true
+}
+
+func (r *Hedge) observe(latency time.Duration) {
+	if !r.adaptive {
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if len(r.latencies) < hedgeSamples {
+		r.latencies = append(r.latencies, latency)
+	} else {
+		r.latencies[r.next] = latency
+		r.next = (r.next + 1) % hedgeSamples
+	}
+}
+
+func (r *Hedge) currentDelay() time.Duration {
+	if !r.adaptive {
+		return r.delay
+	}
+
+	r.mtx.Lock()
+	if len(r.latencies) < hedgeMinSamples {
+		r.mtx.Unlock()
+		return r.delay
+	}
+	latencies := slices.Clone(r.latencies)
+	r.mtx.Unlock()
+
+	slices.Sort(latencies)
+	delay := latencies[len(latencies)*95/100]
+	hedgeDelay.WithLabelValues(r.resource).Set(delay.Seconds())
+
+	return delay
+}
+
+// Hedges holds the hedge of every upstream resource, none when hedging is off.
+type Hedges map[string]*Hedge
+
+func NewHedges() Hedges {
+	hedges := make(Hedges)
+	if !config.TryBool("hedge.enabled", false) {
+		return hedges
+	}
+
+	delay := time.Duration(config.TryInt("hedge.delay", 300)) * time.Millisecond
+	adaptive := config.TryBool("hedge.adaptive", false)
+	maxPercent := config.TryInt("hedge.max-percent", 10)
+
+	for _, resource := range []string{ResourceUsers, ResourcePosts, ResourceTodos, ResourceComments} {
+		hedges[resource] = NewHedge(resource, delay, adaptive, maxPercent)
+	}
+
+	return hedges
+}
+
+func (r Hedges) Do(ctx context.Context, resource string, f func(ctx context.Context) (*rest.Response, error)) (*rest.Response, error) {
+	hedge, ok := r[resource]
+	if !ok {
+		return f(ctx)
+	}
+
+	return hedge.Do(ctx, f)
+}
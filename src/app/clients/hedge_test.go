@@ -0,0 +1,195 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
+)
+
+var errAttempt = errors.New("attempt failed")
+
+func response(status int) *rest.Response {
+	return &rest.Response{Response: &http.Response{StatusCode: status}}
+}
+
+func TestHedge_Win(t *testing.T) {
+	hedge := NewHedge("test-win", 10*time.Millisecond, true, 100)
+
+	var calls atomic.Int32
+	loserDone := make(chan struct{})
+
+	requests := testutil.ToFloat64(hedgeRequests.WithLabelValues("test-win"))
+	wins := testutil.ToFloat64(hedgeWins.WithLabelValues("test-win"))
+
+	start := time.Now()
+	actual, err := hedge.Do(context.Background(), func(ctx context.Context) (*rest.Response, error) {
+		if calls.Add(1) == 1 {
+			// A request already sent ignores the cancellation.
+			<-loserDone
+			return response(http.StatusInternalServerError), nil
+		}
+		return response(http.StatusOK), nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, actual.StatusCode)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, requests+1, testutil.ToFloat64(hedgeRequests.WithLabelValues("test-win")))
+	assert.Equal(t, wins+1, testutil.ToFloat64(hedgeWins.WithLabelValues("test-win")))
+
+	hedge.mtx.Lock()
+	assert.Equal(t, 1, hedge.losers)
+	assert.GreaterOrEqual(t, hedge.latencies[0], 10*time.Millisecond)
+	hedge.mtx.Unlock()
+
+	close(loserDone)
+	assert.Eventually(t, func() bool {
+		hedge.mtx.Lock()
+		defer hedge.mtx.Unlock()
+		return hedge.losers == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestHedge_Losers(t *testing.T) {
+	hedge := NewHedge("test-losers", time.Millisecond, false, 100)
+
+	losersDone := make(chan struct{})
+	defer close(losersDone)
+
+	slowFirst := func() func(ctx context.Context) (*rest.Response, error) {
+		var calls atomic.Int32
+		return func(ctx context.Context) (*rest.Response, error) {
+			if calls.Add(1) == 1 {
+				<-losersDone
+			}
+			return response(http.StatusOK), nil
+		}
+	}
+
+	for i := 0; i < hedgeBurst; i++ {
+		_, err := hedge.Do(context.Background(), slowFirst())
+		require.NoError(t, err)
+	}
+
+	capped := testutil.ToFloat64(hedgeCapped.WithLabelValues("test-losers"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := hedge.Do(ctx, slowFirst())
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, capped+1, testutil.ToFloat64(hedgeCapped.WithLabelValues("test-losers")))
+}
+
+func TestHedge_Fast(t *testing.T) {
+	hedge := NewHedge("test-fast", 50*time.Millisecond, false, 100)
+
+	var calls atomic.Int32
+
+	actual, err := hedge.Do(context.Background(), func(ctx context.Context) (*rest.Response, error) {
+		calls.Add(1)
+		return response(http.StatusOK), nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, actual.StatusCode)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestHedge_Err(t *testing.T) {
+	hedge := NewHedge("test-err", 10*time.Millisecond, false, 100)
+
+	var calls atomic.Int32
+
+	_, err := hedge.Do(context.Background(), func(ctx context.Context) (*rest.Response, error) {
+		calls.Add(1)
+		return nil, errAttempt
+	})
+
+	require.ErrorIs(t, err, errAttempt)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestHedge_Err_Hedged(t *testing.T) {
+	hedge := NewHedge("test-err-hedged", 10*time.Millisecond, false, 100)
+
+	var calls atomic.Int32
+
+	actual, err := hedge.Do(context.Background(), func(ctx context.Context) (*rest.Response, error) {
+		if calls.Add(1) == 1 {
+			time.Sleep(30 * time.Millisecond)
+			return nil, errAttempt
+		}
+		time.Sleep(60 * time.Millisecond)
+		return response(http.StatusOK), nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, actual.StatusCode)
+}
+
+func TestHedge_Capped(t *testing.T) {
+	hedge := NewHedge("test-capped", time.Millisecond, false, 10)
+
+	var calls atomic.Int32
+
+	requests := testutil.ToFloat64(hedgeRequests.WithLabelValues("test-capped"))
+	capped := testutil.ToFloat64(hedgeCapped.WithLabelValues("test-capped"))
+
+	for i := 0; i < 20; i++ {
+		_, err := hedge.Do(context.Background(), func(ctx context.Context) (*rest.Response, error) {
+			calls.Add(1)
+			time.Sleep(5 * time.Millisecond)
+			return response(http.StatusOK), nil
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(22), calls.Load())
+	assert.Equal(t, requests+2, testutil.ToFloat64(hedgeRequests.WithLabelValues("test-capped")))
+	assert.Equal(t, capped+18, testutil.ToFloat64(hedgeCapped.WithLabelValues("test-capped")))
+}
+
+func TestHedge_Adaptive(t *testing.T) {
+	hedge := NewHedge("test-adaptive", time.Second, true, 10)
+
+	assert.Equal(t, time.Second, hedge.currentDelay())
+
+	for i := 1; i <= 100; i++ {
+		hedge.observe(time.Duration(i) * time.Millisecond)
+	}
+
+	assert.Equal(t, 96*time.Millisecond, hedge.currentDelay())
+
+	for i := 0; i < hedgeSamples; i++ {
+		hedge.observe(10 * time.Millisecond)
+	}
+
+	assert.Equal(t, 10*time.Millisecond, hedge.currentDelay())
+}
+
+func TestHedges_Do(t *testing.T) {
+	var calls atomic.Int32
+
+	actual, err := Hedges{}.Do(context.Background(), ResourceUsers, func(ctx context.Context) (*rest.Response, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return response(http.StatusOK), nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, actual.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
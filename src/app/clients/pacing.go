@@ -0,0 +1,50 @@
+package clients
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type pacerKey struct{}
+
+// pacer hands out upstream call slots at least interval apart.
+type pacer struct {
+	mtx      sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// WithPacing spaces the upstream calls made with ctx at least interval apart,
+// concurrent ones included, so that background work trickles into the rate
+// budget instead of spending it in bursts.
+func WithPacing(ctx context.Context, interval time.Duration) context.Context {
+	return context.WithValue(ctx, pacerKey{}, &pacer{interval: interval})
+}
+
+// pace waits for the next slot of the pacer of ctx, if any.
+func pace(ctx context.Context) error {
+	p, found := ctx.Value(pacerKey{}).(*pacer)
+	if !found || p.interval <= 0 {
+		return nil
+	}
+
+	p.mtx.Lock()
+	now := time.Now()
+	slot := p.next
+	if slot.Before(now) {
+		slot = now
+	}
+	p.next = slot.Add(p.interval)
+	p.mtx.Unlock()
+
+	timer := time.NewTimer(slot.Sub(now))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
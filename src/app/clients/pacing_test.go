@@ -0,0 +1,34 @@
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPace(t *testing.T) {
+	ctx := WithPacing(context.Background(), 20*time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, pace(ctx))
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestPace_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(WithPacing(context.Background(), time.Hour))
+
+	require.NoError(t, pace(ctx))
+
+	cancel()
+	assert.ErrorIs(t, pace(ctx), context.Canceled)
+}
+
+func TestPace_Unpaced(t *testing.T) {
+	assert.NoError(t, pace(context.Background()))
+}
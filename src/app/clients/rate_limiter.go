@@ -0,0 +1,216 @@
+package clients
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+var (
+	rateLimitRemaining = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_rate_limit",
+		Name:      "remaining",
+		Help:      "Requests left in the current upstream rate limit window, as last reported by gorest.",
+	})
+	rateLimitLimit = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_rate_limit",
+		Name:      "limit",
+		Help:      "Requests allowed per upstream rate limit window, as last reported by gorest.",
+	})
+	rateLimitWait = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "gorest_api",
+		Subsystem: "upstream_rate_limit",
+		Name:      "wait_seconds",
+		Help:      "Time upstream requests were held back by the client-side rate limiter.",
+		Buckets:   []float64{.001, .01, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	})
+)
+
+// epochThreshold tells a reset given as a unix time apart from one given as
+// seconds left in the window.
+const epochThreshold = 1_000_000_000
+
+type IRateLimiter interface {
+	Wait(ctx context.Context) error
+	Update(status int, header http.Header)
+	Snapshot() model.RateLimitDTO
+}
+
+// RateLimiter is a token bucket adapting to the X-RateLimit headers of every
+// response: what is left of the budget, minus a reserve, is spread evenly over
+// the rest of the window, so requests slow down instead of hitting a 429.
+type RateLimiter struct {
+	reservePercent int
+	burst          float64
+	now            func() time.Time
+
+	mtx       sync.Mutex
+	known     bool
+	limit     int
+	remaining int
+	reserve   int
+	resetAt   time.Time
+	rate      float64
+	tokens    float64
+	last      time.Time
+	updatedAt time.Time
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		reservePercent: config.TryInt("rate-limit.reserve-percent", 10),
+		burst:          float64(max(config.TryInt("rate-limit.burst", 10), 1)),
+		now:            time.Now,
+	}
+}
+
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.mtx.Lock()
+	now := r.now()
+	r.refill(now)
+
+	if !r.known || r.tokens >= 1 {
+		if r.known {
+			r.tokens--
+		}
+		r.mtx.Unlock()
+		return nil
+	}
+
+	var wait time.Duration
+	if r.rate > 0 {
+		wait = time.Duration((1 - r.tokens) / r.rate * float64(time.Second))
+	}
+	if resetIn := r.resetAt.Sub(now); r.rate == 0 || wait > resetIn {
+		wait = resetIn
+	}
+	r.tokens--
+	r.mtx.Unlock()
+
+	rateLimitWait.Observe(wait.Seconds())
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.mtx.Lock()
+		r.tokens++
+		r.mtx.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (r *RateLimiter) Update(status int, header http.Header) {
+	now := r.now()
+
+	limit, limitErr := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	remaining, remainingErr := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	reset, resetErr := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if status == http.StatusTooManyRequests {
+		retryAfter, err := strconv.Atoi(header.Get("Retry-After"))
+		if err != nil {
+			retryAfter = 1
+		}
+		r.known = true
+		r.remaining = 0
+		r.resetAt = now.Add(time.Duration(retryAfter) * time.Second)
+		r.rate = 0
+		r.tokens = 0
+		r.last = now
+		r.updatedAt = now
+		r.report()
+		return
+	}
+
+	if limitErr != nil || remainingErr != nil || resetErr != nil {
+		return
+	}
+
+	resetAt := now.Add(time.Duration(reset) * time.Second)
+	if reset >= epochThreshold {
+		resetAt = time.Unix(reset, 0)
+	}
+	window := math.Max(resetAt.Sub(now).Seconds(), 1)
+
+	r.refill(now)
+	fresh := !r.known
+
+	r.known = true
+	r.limit = limit
+	r.remaining = remaining
+	r.reserve = int(math.Ceil(float64(limit*r.reservePercent) / 100))
+	r.resetAt = now.Add(time.Duration(window * float64(time.Second)))
+	r.last = now
+	r.updatedAt = now
+
+	available := math.Max(float64(remaining-r.reserve), 0)
+	r.rate = available / window
+
+	if fresh {
+		r.tokens = math.Min(available, r.burst)
+	} else {
+		r.tokens = math.Min(r.tokens, available)
+	}
+
+	r.report()
+}
+
+func (r *RateLimiter) Snapshot() model.RateLimitDTO {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.refill(r.now())
+
+	return model.RateLimitDTO{
+		Known:         r.known,
+		Limit:         r.limit,
+		Remaining:     r.remaining,
+		Reserve:       r.reserve,
+		ResetAt:       r.resetAt,
+		RatePerSecond: r.rate,
+		Tokens:        r.tokens,
+		Throttled:     r.throttled(),
+		UpdatedAt:     r.updatedAt,
+	}
+}
+
+func (r *RateLimiter) refill(now time.Time) {
+	if !r.known {
+		return
+	}
+
+	if !now.Before(r.resetAt) {
+		r.known = false
+		r.tokens = 0
+		return
+	}
+
+	r.tokens = math.Min(r.tokens+now.Sub(r.last).Seconds()*r.rate, r.burst)
+	r.last = now
+}
+
+func (r *RateLimiter) throttled() bool {
+	return r.known && r.tokens < 1
+}
+
+func (r *RateLimiter) report() {
+	rateLimitRemaining.Set(float64(r.remaining))
+	rateLimitLimit.Set(float64(r.limit))
+}
@@ -0,0 +1,120 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimiter(now *time.Time) *RateLimiter {
+	return &RateLimiter{
+		reservePercent: 10,
+		burst:          10,
+		now: func() time.Time {
+			return *now
+		},
+	}
+}
+
+func rateLimitHeader(limit int, remaining int, reset int64) http.Header {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+	return header
+}
+
+func TestRateLimiter_Unknown(t *testing.T) {
+	now := time.Now()
+	limiter := newTestRateLimiter(&now)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+
+	limiter.Update(http.StatusOK, http.Header{})
+	assert.False(t, limiter.Snapshot().Known)
+}
+
+func TestRateLimiter_Update(t *testing.T) {
+	now := time.Now()
+	limiter := newTestRateLimiter(&now)
+
+	limiter.Update(http.StatusOK, rateLimitHeader(100, 50, 10))
+
+	snapshot := limiter.Snapshot()
+	assert.True(t, snapshot.Known)
+	assert.Equal(t, 100, snapshot.Limit)
+	assert.Equal(t, 50, snapshot.Remaining)
+	assert.Equal(t, 10, snapshot.Reserve)
+	assert.InDelta(t, 4, snapshot.RatePerSecond, 0.001)
+	assert.InDelta(t, 10, snapshot.Tokens, 0.001)
+	assert.Equal(t, now.Add(10*time.Second), snapshot.ResetAt)
+	assert.False(t, snapshot.Throttled)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+	assert.True(t, limiter.Snapshot().Throttled)
+
+	now = now.Add(500 * time.Millisecond)
+	assert.InDelta(t, 2, limiter.Snapshot().Tokens, 0.001)
+
+	limiter.Update(http.StatusOK, rateLimitHeader(100, 11, 10))
+	assert.InDelta(t, 1, limiter.Snapshot().Tokens, 0.001)
+}
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	now := time.Now()
+	limiter := newTestRateLimiter(&now)
+
+	limiter.Update(http.StatusOK, rateLimitHeader(100, 10, 1))
+
+	snapshot := limiter.Snapshot()
+	assert.Zero(t, snapshot.RatePerSecond)
+	assert.True(t, snapshot.Throttled)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+
+	now = now.Add(time.Second)
+	assert.False(t, limiter.Snapshot().Known)
+	require.NoError(t, limiter.Wait(context.Background()))
+}
+
+func TestRateLimiter_TooManyRequests(t *testing.T) {
+	now := time.Now()
+	limiter := newTestRateLimiter(&now)
+
+	header := http.Header{}
+	header.Set("Retry-After", "2")
+
+	limiter.Update(http.StatusTooManyRequests, header)
+
+	snapshot := limiter.Snapshot()
+	assert.True(t, snapshot.Throttled)
+	assert.Equal(t, now.Add(2*time.Second), snapshot.ResetAt)
+}
+
+func TestRateLimiter_EpochReset(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	limiter := newTestRateLimiter(&now)
+
+	limiter.Update(http.StatusOK, rateLimitHeader(100, 90, now.Add(20*time.Second).Unix()))
+
+	snapshot := limiter.Snapshot()
+	assert.Equal(t, now.Add(20*time.Second), snapshot.ResetAt)
+	assert.InDelta(t, 4, snapshot.RatePerSecond, 0.001)
+}
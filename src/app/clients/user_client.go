@@ -1,8 +1,11 @@
 package clients
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
@@ -12,106 +15,162 @@ import (
 	"gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
 )
 
+var ErrNotFound = errors.New("not found")
+
 type IUserClient interface {
-	GetUsers(page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error)
-	GetUser(userID int) (*model.UserResponse, error)
-	GetPosts(userID int) ([]model.PostResponse, error)
-	GetTodos(userID int) ([]model.TodoResponse, error)
-	GetComments(postID int) ([]model.CommentResponse, error)
+	GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error)
+	GetUser(ctx context.Context, userID int) (*model.UserResponse, error)
+	GetAllPosts(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.PostResponse], error)
+	GetPost(ctx context.Context, postID int) (*model.PostResponse, error)
+	GetAllComments(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error)
+	GetCommentsByEmail(ctx context.Context, email string, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error)
+	GetAllTodos(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.TodoResponse], error)
+	GetComment(ctx context.Context, commentID int) (*model.CommentResponse, error)
+	GetTodo(ctx context.Context, todoID int) (*model.TodoResponse, error)
+	GetPosts(ctx context.Context, userID int) ([]model.PostResponse, error)
+	GetTodos(ctx context.Context, userID int) ([]model.TodoResponse, error)
+	GetComments(ctx context.Context, postID int) ([]model.CommentResponse, error)
+}
+
+// IRequestBuilder issues the upstream GETs bound to ctx, so that cancelling it
+// aborts the request in flight.
+type IRequestBuilder interface {
+	Get(ctx context.Context, url string) *rest.Response
 }
 
 type UserClient struct {
-	rb rest.IRequestBuilder
+	rb        IRequestBuilder
+	limiter   IRateLimiter
+	bulkheads Bulkheads
+	hedges    Hedges
 }
 
-func NewUserClient(rb rest.IRequestBuilder) *UserClient {
+func NewUserClient(rb IRequestBuilder, limiter IRateLimiter, bulkheads Bulkheads, hedges Hedges) *UserClient {
 	return &UserClient{
-		rb: rb,
+		rb:        rb,
+		limiter:   limiter,
+		bulkheads: bulkheads,
+		hedges:    hedges,
 	}
 }
 
-func (c *UserClient) GetUsers(page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error) {
-	apiURL := "/users"
+func (c *UserClient) GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error) {
+	return getPaged[model.UserResponse](ctx, c, ResourceUsers, "/users", url.Values{}, page, perPage)
+}
 
-	if page > 0 {
-		apiURL += "?page=" + strconv.Itoa(page)
-	}
+func (c *UserClient) GetAllPosts(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.PostResponse], error) {
+	return getPaged[model.PostResponse](ctx, c, ResourcePosts, "/posts", url.Values{}, page, perPage)
+}
 
-	if perPage > 0 {
-		apiURL += "&per_page=" + strconv.Itoa(perPage)
+func (c *UserClient) GetAllComments(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	return getPaged[model.CommentResponse](ctx, c, ResourceComments, "/comments", url.Values{}, page, perPage)
+}
+
+func (c *UserClient) GetCommentsByEmail(ctx context.Context, email string, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	return getPaged[model.CommentResponse](ctx, c, ResourceComments, "/comments", url.Values{"email": {email}}, page, perPage)
+}
+
+func (c *UserClient) GetAllTodos(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.TodoResponse], error) {
+	return getPaged[model.TodoResponse](ctx, c, ResourceTodos, "/todos", url.Values{}, page, perPage)
+}
+
+func (c *UserClient) GetPost(ctx context.Context, postID int) (*model.PostResponse, error) {
+	apiURL := fmt.Sprintf("/posts/%d", postID)
+	response, err := c.get(ctx, ResourcePosts, apiURL)
+	if err != nil {
+		return nil, err
 	}
 
-	response := c.rb.Get(apiURL)
-	if response.Err != nil {
-		return nil, response.Err
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: post %d", ErrNotFound, postID)
 	}
 
 	if response.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
 	}
 
-	var userResponses []model.UserResponse
-	if err := response.FillUp(&userResponses); err != nil {
+	postResponse := new(model.PostResponse)
+	if err := response.FillUp(postResponse); err != nil {
 		return nil, err
 	}
 
-	limit, err := strconv.Atoi(response.Header.Get("X-Pagination-Limit"))
+	return postResponse, nil
+}
+
+func (c *UserClient) GetUser(ctx context.Context, userID int) (*model.UserResponse, error) {
+	apiURL := fmt.Sprintf("/users/%d", userID)
+	response, err := c.get(ctx, ResourceUsers, apiURL)
 	if err != nil {
 		return nil, err
 	}
 
-	pageNumber, err := strconv.Atoi(response.Header.Get("X-Pagination-Page"))
-	if err != nil {
-		return nil, err
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: user %d", ErrNotFound, userID)
 	}
 
-	pages, err := strconv.Atoi(response.Header.Get("X-Pagination-Pages"))
-	if err != nil {
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	userResponse := new(model.UserResponse)
+	if err := response.FillUp(userResponse); err != nil {
 		return nil, err
 	}
 
-	total, err := strconv.Atoi(response.Header.Get("X-Pagination-Total"))
+	return userResponse, nil
+}
+
+func (c *UserClient) GetComment(ctx context.Context, commentID int) (*model.CommentResponse, error) {
+	apiURL := fmt.Sprintf("/comments/%d", commentID)
+	response, err := c.get(ctx, ResourceComments, apiURL)
 	if err != nil {
 		return nil, err
 	}
 
-	pagedResult := &paging.PagedResultResponse[model.UserResponse]{
-		Limit:   limit,
-		Page:    pageNumber,
-		Pages:   pages,
-		Total:   total,
-		Results: userResponses,
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: comment %d", ErrNotFound, commentID)
 	}
 
-	return pagedResult, nil
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	commentResponse := new(model.CommentResponse)
+	if err := response.FillUp(commentResponse); err != nil {
+		return nil, err
+	}
+
+	return commentResponse, nil
 }
 
-func (c *UserClient) GetUser(userID int) (*model.UserResponse, error) {
-	apiURL := fmt.Sprintf("/users/%d", userID)
-	response := c.rb.Get(apiURL)
+func (c *UserClient) GetTodo(ctx context.Context, todoID int) (*model.TodoResponse, error) {
+	apiURL := fmt.Sprintf("/todos/%d", todoID)
+	response, err := c.get(ctx, ResourceTodos, apiURL)
+	if err != nil {
+		return nil, err
+	}
 
-	if response.Err != nil {
-		return nil, response.Err
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: todo %d", ErrNotFound, todoID)
 	}
 
 	if response.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
 	}
 
-	userResponse := new(model.UserResponse)
-	if err := response.FillUp(userResponse); err != nil {
+	todoResponse := new(model.TodoResponse)
+	if err := response.FillUp(todoResponse); err != nil {
 		return nil, err
 	}
 
-	return userResponse, nil
+	return todoResponse, nil
 }
 
-func (c *UserClient) GetPosts(userID int) ([]model.PostResponse, error) {
+func (c *UserClient) GetPosts(ctx context.Context, userID int) ([]model.PostResponse, error) {
 	apiURL := fmt.Sprintf("/users/%d/posts", userID)
-	response := c.rb.Get(apiURL)
-
-	if response.Err != nil {
-		return nil, response.Err
+	response, err := c.get(ctx, ResourcePosts, apiURL)
+	if err != nil {
+		return nil, err
 	}
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
@@ -126,12 +185,11 @@ func (c *UserClient) GetPosts(userID int) ([]model.PostResponse, error) {
 	return postResponses, nil
 }
 
-func (c *UserClient) GetComments(postID int) ([]model.CommentResponse, error) {
+func (c *UserClient) GetComments(ctx context.Context, postID int) ([]model.CommentResponse, error) {
 	apiURL := fmt.Sprintf("/posts/%d/comments", postID)
-	response := c.rb.Get(apiURL)
-
-	if response.Err != nil {
-		return nil, response.Err
+	response, err := c.get(ctx, ResourceComments, apiURL)
+	if err != nil {
+		return nil, err
 	}
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
@@ -146,12 +204,11 @@ func (c *UserClient) GetComments(postID int) ([]model.CommentResponse, error) {
 	return commentResponses, nil
 }
 
-func (c *UserClient) GetTodos(userID int) ([]model.TodoResponse, error) {
+func (c *UserClient) GetTodos(ctx context.Context, userID int) ([]model.TodoResponse, error) {
 	apiURL := fmt.Sprintf("/users/%d/todos", userID)
-	response := c.rb.Get(apiURL)
-
-	if response.Err != nil {
-		return nil, response.Err
+	response, err := c.get(ctx, ResourceTodos, apiURL)
+	if err != nil {
+		return nil, err
 	}
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
@@ -165,3 +222,94 @@ func (c *UserClient) GetTodos(userID int) ([]model.TodoResponse, error) {
 
 	return todoResponses, nil
 }
+
+// get hedges slow requests, each attempt taking its own upstream slot.
+func (c *UserClient) get(ctx context.Context, resource string, apiURL string) (*rest.Response, error) {
+	return c.hedges.Do(ctx, resource, func(ctx context.Context) (*rest.Response, error) {
+		return c.attempt(ctx, resource, apiURL)
+	})
+}
+
+// attempt waits for the rate limit before taking an upstream slot, so that a
+// throttled request does not hold the slot while waiting.
+func (c *UserClient) attempt(ctx context.Context, resource string, apiURL string) (*rest.Response, error) {
+	if err := pace(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	release, err := c.bulkheads.Acquire(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	response := c.rb.Get(ctx, apiURL)
+	if response.Err != nil {
+		return nil, response.Err
+	}
+
+	return response, nil
+}
+
+func getPaged[T any](ctx context.Context, c *UserClient, resource string, path string, query url.Values, page int, perPage int) (*paging.PagedResultResponse[T], error) {
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+
+	if perPage > 0 {
+		query.Set("per_page", strconv.Itoa(perPage))
+	}
+
+	apiURL := path
+	if len(query) > 0 {
+		apiURL += "?" + query.Encode()
+	}
+
+	response, err := c.get(ctx, resource, apiURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	var results []T
+	if err := response.FillUp(&results); err != nil {
+		return nil, err
+	}
+
+	limit, err := strconv.Atoi(response.Header.Get("X-Pagination-Limit"))
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber, err := strconv.Atoi(response.Header.Get("X-Pagination-Page"))
+	if err != nil {
+		return nil, err
+	}
+
+	pages, err := strconv.Atoi(response.Header.Get("X-Pagination-Pages"))
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := strconv.Atoi(response.Header.Get("X-Pagination-Total"))
+	if err != nil {
+		return nil, err
+	}
+
+	pagedResult := &paging.PagedResultResponse[T]{
+		Limit:   limit,
+		Page:    pageNumber,
+		Pages:   pages,
+		Total:   total,
+		Results: results,
+	}
+
+	return pagedResult, nil
+}
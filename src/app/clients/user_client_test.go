@@ -0,0 +1,77 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
+)
+
+var errRecorded = errors.New("request recorded")
+
+func TestUserClient_GetPaged_URL(t *testing.T) {
+	tests := []struct {
+		name    string
+		get     func(c *UserClient) error
+		wantURL string
+	}{
+		{
+			name: "page 0 only",
+			get: func(c *UserClient) error {
+				_, err := c.GetUsers(context.Background(), 0, 0)
+				return err
+			},
+			wantURL: "/users",
+		},
+		{
+			name: "page only",
+			get: func(c *UserClient) error {
+				_, err := c.GetAllPosts(context.Background(), 2, 0)
+				return err
+			},
+			wantURL: "/posts?page=2",
+		},
+		{
+			name: "per_page only, page 0",
+			get: func(c *UserClient) error {
+				_, err := c.GetUsers(context.Background(), 0, 20)
+				return err
+			},
+			wantURL: "/users?per_page=20",
+		},
+		{
+			name: "page and per_page",
+			get: func(c *UserClient) error {
+				_, err := c.GetAllTodos(context.Background(), 3, 50)
+				return err
+			},
+			wantURL: "/todos?page=3&per_page=50",
+		},
+		{
+			name: "filter, page and per_page",
+			get: func(c *UserClient) error {
+				_, err := c.GetCommentsByEmail(context.Background(), "a+b@example.com", 1, 10)
+				return err
+			},
+			wantURL: "/comments?email=a%2Bb%40example.com&page=1&per_page=10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := mocks.NewMockIRateLimiter(t)
+			limiter.EXPECT().Wait(mock.Anything).Return(nil)
+
+			rb := mocks.NewMockIRequestBuilder(t)
+			rb.EXPECT().Get(mock.Anything, tt.wantURL).Return(&rest.Response{Err: errRecorded}).Once()
+
+			err := tt.get(NewUserClient(rb, limiter, Bulkheads{}, Hedges{}))
+
+			require.ErrorIs(t, err, errRecorded)
+		})
+	}
+}
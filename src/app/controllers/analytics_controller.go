@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+type IAnalyticsController interface {
+	GetAnalytics(ctx *routing.HTTPContext) error
+}
+
+type AnalyticsController struct {
+	analyticsService services.IAnalyticsService
+	responder        IResponder
+	policy           caching.Policy
+}
+
+func NewAnalyticsController(analyticsService services.IAnalyticsService, responder IResponder) *AnalyticsController {
+	return &AnalyticsController{
+		analyticsService: analyticsService,
+		responder:        responder,
+		policy:           caching.NewPolicy("analytics"),
+	}
+}
+
+func (r AnalyticsController) GetAnalytics(ctx *routing.HTTPContext) error {
+	analyticsDTO, err := r.analyticsService.GetAnalytics()
+	if errors.Is(err, services.ErrAnalyticsPending) {
+		ctx.Set("Retry-After", "30")
+		return ctx.Status(http.StatusAccepted).JSON(map[string]string{
+			"status": "pending",
+			"detail": err.Error(),
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return r.responder.Send(ctx, r.policy, analyticsDTO)
+}
@@ -0,0 +1,46 @@
+package binding
+
+import (
+	"net/http"
+
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+const ProblemContentType = "application/problem+json"
+
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Problem is an RFC 7807 problem details document.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+func NewProblem(status int, detail string) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+func (r *Problem) Error() string {
+	return r.Detail
+}
+
+func WriteProblem(ctx *routing.HTTPContext, problem *Problem) error {
+	if problem.Instance == "" {
+		problem.Instance = ctx.Path()
+	}
+
+	return ctx.Status(problem.Status).JSON(problem, ProblemContentType)
+}
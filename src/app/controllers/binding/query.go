@@ -0,0 +1,97 @@
+package binding
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type Querier interface {
+	Query(key string, defaultValue ...string) string
+}
+
+type IntParam struct {
+	Name    string
+	Default int
+	Min     int
+	Max     int
+	// Clamp brings values above Max down to Max instead of rejecting them.
+	Clamp bool
+}
+
+type QueryBinder struct {
+	querier       Querier
+	invalidParams []InvalidParam
+}
+
+func NewQueryBinder(querier Querier) *QueryBinder {
+	return &QueryBinder{
+		querier: querier,
+	}
+}
+
+func (r *QueryBinder) Int(param IntParam) int {
+	value := r.querier.Query(param.Name)
+	if value == "" {
+		return param.Default
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		r.Reject(param.Name, "must be an integer")
+		return param.Default
+	}
+
+	if number < param.Min {
+		r.Reject(param.Name, fmt.Sprintf("must be greater than or equal to %d", param.Min))
+		return param.Default
+	}
+
+	if param.Max > 0 && number > param.Max {
+		if param.Clamp {
+			return param.Max
+		}
+		r.Reject(param.Name, fmt.Sprintf("must be less than or equal to %d", param.Max))
+		return param.Default
+	}
+
+	return number
+}
+
+func (r *QueryBinder) Bool(name string, defaultValue bool) bool {
+	value := r.querier.Query(name)
+	if value == "" {
+		return defaultValue
+	}
+
+	boolean, err := strconv.ParseBool(value)
+	if err != nil {
+		r.Reject(name, "must be a boolean")
+		return defaultValue
+	}
+
+	return boolean
+}
+
+// Problem returns nil when every bound parameter is valid.
+func (r *QueryBinder) Problem() *Problem {
+	if len(r.invalidParams) == 0 {
+		return nil
+	}
+
+	problem := NewProblem(http.StatusBadRequest, "One or more query parameters are invalid.")
+	problem.InvalidParams = r.invalidParams
+
+	return problem
+}
+
+func (r *QueryBinder) String(name string, defaultValue string) string {
+	return r.querier.Query(name, defaultValue)
+}
+
+func (r *QueryBinder) Reject(name string, reason string) {
+	r.invalidParams = append(r.invalidParams, InvalidParam{
+		Name:   name,
+		Reason: reason,
+	})
+}
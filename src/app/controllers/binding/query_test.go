@@ -0,0 +1,69 @@
+package binding_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/binding"
+)
+
+type querier map[string]string
+
+func (r querier) Query(key string, _ ...string) string {
+	return r[key]
+}
+
+var perPageParam = binding.IntParam{Name: "per_page", Default: 10, Min: 1, Max: 100, Clamp: true}
+
+func TestQueryBinder_Int(t *testing.T) {
+	query := binding.NewQueryBinder(querier{"page": "3", "per_page": "20"})
+
+	assert.Equal(t, 3, query.Int(binding.IntParam{Name: "page", Default: 1, Min: 1}))
+	assert.Equal(t, 20, query.Int(perPageParam))
+	assert.Nil(t, query.Problem())
+}
+
+func TestQueryBinder_Int_Default(t *testing.T) {
+	query := binding.NewQueryBinder(querier{})
+
+	assert.Equal(t, 10, query.Int(perPageParam))
+	assert.Nil(t, query.Problem())
+}
+
+func TestQueryBinder_Int_Clamp(t *testing.T) {
+	query := binding.NewQueryBinder(querier{"per_page": "100000"})
+
+	assert.Equal(t, 100, query.Int(perPageParam))
+	assert.Nil(t, query.Problem())
+}
+
+func TestQueryBinder_Int_Invalid(t *testing.T) {
+	query := binding.NewQueryBinder(querier{"page": "-3", "per_page": "ten", "limit": "500"})
+
+	query.Int(binding.IntParam{Name: "page", Default: 1, Min: 1})
+	query.Int(perPageParam)
+	query.Int(binding.IntParam{Name: "limit", Default: 1, Min: 1, Max: 50})
+
+	problem := query.Problem()
+	require.NotNil(t, problem)
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, []binding.InvalidParam{
+		{Name: "page", Reason: "must be greater than or equal to 1"},
+		{Name: "per_page", Reason: "must be an integer"},
+		{Name: "limit", Reason: "must be less than or equal to 50"},
+	}, problem.InvalidParams)
+}
+
+func TestQueryBinder_Bool(t *testing.T) {
+	query := binding.NewQueryBinder(querier{"stats": "true", "verbose": "maybe"})
+
+	assert.True(t, query.Bool("stats", false))
+	assert.True(t, query.Bool("missing", true))
+	assert.False(t, query.Bool("verbose", false))
+
+	problem := query.Problem()
+	require.NotNil(t, problem)
+	assert.Equal(t, []binding.InvalidParam{{Name: "verbose", Reason: "must be a boolean"}}, problem.InvalidParams)
+}
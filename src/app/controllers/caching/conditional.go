@@ -0,0 +1,135 @@
+package caching
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+const maxVersions = 10_000
+
+type Policy struct {
+	CacheControl string
+	LastModified bool
+}
+
+// NewPolicy reads the caching policy of a route from the routes.<route>.* config keys.
+func NewPolicy(route string) Policy {
+	return Policy{
+		CacheControl: config.TryString("routes."+route+".cache-control", "no-cache"),
+		LastModified: config.TryBool("routes."+route+".last-modified", false),
+	}
+}
+
+type Request struct {
+	Key             string
+	ContentType     string
+	IfNoneMatch     string
+	IfModifiedSince string
+}
+
+type Result struct {
+	Headers     map[string]string
+	NotModified bool
+}
+
+type IConditional interface {
+	Evaluate(policy Policy, request Request, body []byte) Result
+}
+
+type version struct {
+	etag         string
+	lastModified time.Time
+}
+
+type Conditional struct {
+	mtx      sync.Mutex
+	versions map[string]version
+	now      func() time.Time
+}
+
+func NewConditional() *Conditional {
+	return &Conditional{
+		versions: make(map[string]version),
+		now:      time.Now,
+	}
+}
+
+func (r *Conditional) Evaluate(policy Policy, request Request, body []byte) Result {
+	etag := ETag(request.ContentType, body)
+
+	result := Result{
+		Headers: map[string]string{
+			"ETag":          etag,
+			"Cache-Control": policy.CacheControl,
+		},
+	}
+
+	var lastModified time.Time
+	if policy.LastModified {
+		lastModified = r.lastModified(request.Key, request.ContentType, etag)
+		result.Headers["Last-Modified"] = lastModified.Format(http.TimeFormat)
+	}
+
+	if request.IfNoneMatch != "" {
+		result.NotModified = matches(request.IfNoneMatch, etag)
+		return result
+	}
+
+	if policy.LastModified && request.IfModifiedSince != "" {
+		if since, err := http.ParseTime(request.IfModifiedSince); err == nil {
+			result.NotModified = !lastModified.After(since)
+		}
+	}
+
+	return result
+}
+
+// lastModified returns the first time the current etag was served for the key
+// in the content type, every representation of a resource having its own.
+func (r *Conditional) lastModified(key string, contentType string, etag string) time.Time {
+	key += " " + contentType
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if current, found := r.versions[key]; found && current.etag == etag {
+		return current.lastModified
+	}
+
+	if len(r.versions) >= maxVersions {
+		clear(r.versions)
+	}
+
+	current := version{
+		etag:         etag,
+		lastModified: r.now().UTC().Truncate(time.Second),
+	}
+	r.versions[key] = current
+
+	return current.lastModified
+}
+
+func ETag(contentType string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(contentType))
+	hash.Write(body)
+
+	return `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+func matches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
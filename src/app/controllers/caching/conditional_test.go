@@ -0,0 +1,87 @@
+package caching
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var policy = Policy{CacheControl: "private, max-age=5", LastModified: true}
+
+func newConditional(now *time.Time) *Conditional {
+	conditional := NewConditional()
+	conditional.now = func() time.Time { return *now }
+
+	return conditional
+}
+
+func TestConditional_Evaluate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	conditional := newConditional(&now)
+
+	result := conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/json"}, []byte(`{"total":1}`))
+
+	assert.False(t, result.NotModified)
+	assert.Equal(t, ETag("application/json", []byte(`{"total":1}`)), result.Headers["ETag"])
+	assert.Equal(t, "private, max-age=5", result.Headers["Cache-Control"])
+	assert.Equal(t, "Fri, 01 Mar 2024 10:00:00 GMT", result.Headers["Last-Modified"])
+}
+
+func TestConditional_Evaluate_IfNoneMatch(t *testing.T) {
+	now := time.Now()
+	conditional := newConditional(&now)
+	body := []byte(`{"total":1}`)
+	etag := ETag("application/json", body)
+
+	result := conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/json", IfNoneMatch: `"other", ` + etag}, body)
+	assert.True(t, result.NotModified)
+
+	result = conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/json", IfNoneMatch: "W/" + etag}, body)
+	assert.True(t, result.NotModified)
+
+	result = conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/xml", IfNoneMatch: etag}, body)
+	assert.False(t, result.NotModified)
+}
+
+func TestConditional_Evaluate_IfModifiedSince(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	conditional := newConditional(&now)
+
+	conditional.Evaluate(policy, Request{Key: "/users"}, []byte("v1"))
+
+	now = now.Add(time.Minute)
+	result := conditional.Evaluate(policy, Request{Key: "/users", IfModifiedSince: "Fri, 01 Mar 2024 10:00:00 GMT"}, []byte("v1"))
+	assert.True(t, result.NotModified)
+	assert.Equal(t, "Fri, 01 Mar 2024 10:00:00 GMT", result.Headers["Last-Modified"])
+
+	result = conditional.Evaluate(policy, Request{Key: "/users", IfModifiedSince: "Fri, 01 Mar 2024 10:00:00 GMT"}, []byte("v2"))
+	assert.False(t, result.NotModified)
+	assert.Equal(t, now.Format(http.TimeFormat), result.Headers["Last-Modified"])
+}
+
+func TestConditional_Evaluate_ContentTypes(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	conditional := newConditional(&now)
+
+	conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/json"}, []byte(`{"total":1}`))
+	conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/xml"}, []byte("<total>1</total>"))
+
+	now = now.Add(time.Minute)
+	result := conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/json", IfModifiedSince: "Fri, 01 Mar 2024 10:00:00 GMT"}, []byte(`{"total":1}`))
+	assert.True(t, result.NotModified)
+
+	result = conditional.Evaluate(policy, Request{Key: "/users", ContentType: "application/xml", IfModifiedSince: "Fri, 01 Mar 2024 10:00:00 GMT"}, []byte("<total>1</total>"))
+	assert.True(t, result.NotModified)
+	assert.Equal(t, "Fri, 01 Mar 2024 10:00:00 GMT", result.Headers["Last-Modified"])
+}
+
+func TestConditional_Evaluate_WithoutLastModified(t *testing.T) {
+	conditional := NewConditional()
+
+	result := conditional.Evaluate(Policy{CacheControl: "no-cache"}, Request{Key: "/users", IfModifiedSince: time.Now().Format(http.TimeFormat)}, []byte("v1"))
+
+	assert.False(t, result.NotModified)
+	assert.NotContains(t, result.Headers, "Last-Modified")
+}
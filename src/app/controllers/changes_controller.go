@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/binding"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+// newChangesLimitParam reads the limit bounds from routes.changes.limit.*,
+// clamping values above the maximum.
+func newChangesLimitParam() binding.IntParam {
+	return binding.IntParam{
+		Name:    "limit",
+		Default: config.TryInt("routes.changes.limit.default", 100),
+		Min:     1,
+		Max:     config.TryInt("routes.changes.limit.max", 1000),
+		Clamp:   true,
+	}
+}
+
+type IChangesController interface {
+	GetChanges(ctx *routing.HTTPContext) error
+}
+
+type ChangesController struct {
+	changesService services.IChangesService
+	responder      IResponder
+	policy         caching.Policy
+	limitParam     binding.IntParam
+}
+
+func NewChangesController(changesService services.IChangesService, responder IResponder) *ChangesController {
+	return &ChangesController{
+		changesService: changesService,
+		responder:      responder,
+		policy:         caching.NewPolicy("changes"),
+		limitParam:     newChangesLimitParam(),
+	}
+}
+
+func (r ChangesController) GetChanges(ctx *routing.HTTPContext) error {
+	query := binding.NewQueryBinder(ctx)
+
+	var since time.Time
+	if value := query.String("since", ""); value != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			query.Reject("since", "must be an RFC 3339 timestamp")
+		}
+		since = parsed
+	}
+
+	cursor := query.String("cursor", "")
+	if cursor != "" && !since.IsZero() {
+		query.Reject("cursor", "cannot be combined with since")
+	}
+
+	limit := query.Int(r.limitParam)
+
+	if problem := query.Problem(); problem != nil {
+		return binding.WriteProblem(ctx, problem)
+	}
+
+	changesDTO, err := r.changesService.GetChanges(since, cursor, limit)
+	switch {
+	case errors.Is(err, mirror.ErrChangesDisabled):
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusNotFound, err.Error()))
+	case errors.Is(err, mirror.ErrInvalidChangesCursor):
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusBadRequest, err.Error()))
+	case errors.Is(err, mirror.ErrForeignChangesCursor):
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusConflict, err.Error()))
+	case errors.Is(err, mirror.ErrChangesExpired):
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusGone, err.Error()))
+	case errors.Is(err, mirror.ErrNotSynced):
+		ctx.Set("Retry-After", "30")
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusServiceUnavailable, err.Error()))
+	case err != nil:
+		return err
+	}
+
+	if changesDTO.HasMore {
+		if requestURL, parseErr := url.Parse(ctx.BaseURL() + ctx.OriginalURL()); parseErr == nil {
+			values := requestURL.Query()
+			values.Del("since")
+			values.Set("cursor", changesDTO.NextCursor)
+			requestURL.RawQuery = values.Encode()
+
+			ctx.Set("Link", (&paging.Links{Next: requestURL.String()}).String())
+		}
+	}
+
+	return r.responder.Send(ctx, r.policy, changesDTO)
+}
@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"strings"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/binding"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+type ICommentsController interface {
+	GetComments(ctx *routing.HTTPContext) error
+}
+
+type CommentsController struct {
+	commentsService services.ICommentsService
+	responder       IResponder
+	policy          caching.Policy
+}
+
+func NewCommentsController(commentsService services.ICommentsService, responder IResponder) *CommentsController {
+	return &CommentsController{
+		commentsService: commentsService,
+		responder:       responder,
+		policy:          caching.NewPolicy("comments"),
+	}
+}
+
+func (r CommentsController) GetComments(ctx *routing.HTTPContext) error {
+	query := binding.NewQueryBinder(ctx)
+
+	email := strings.TrimSpace(query.String("email", ""))
+	if !strings.Contains(email, "@") {
+		query.Reject("email", "must be an email address")
+	}
+
+	if problem := query.Problem(); problem != nil {
+		return binding.WriteProblem(ctx, problem)
+	}
+
+	commentSearchDTO, err := r.commentsService.FindByEmail(requestContext(ctx), email)
+	if err != nil {
+		return err
+	}
+
+	return r.responder.Send(ctx, r.policy, commentSearchDTO)
+}
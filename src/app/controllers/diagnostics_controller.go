@@ -0,0 +1,29 @@
+package controllers
+
+import (
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+type IDiagnosticsController interface {
+	GetRateLimit(ctx *routing.HTTPContext) error
+}
+
+type DiagnosticsController struct {
+	rateLimiter clients.IRateLimiter
+	responder   IResponder
+	policy      caching.Policy
+}
+
+func NewDiagnosticsController(rateLimiter clients.IRateLimiter, responder IResponder) *DiagnosticsController {
+	return &DiagnosticsController{
+		rateLimiter: rateLimiter,
+		responder:   responder,
+		policy:      caching.NewPolicy("rate-limit"),
+	}
+}
+
+func (r DiagnosticsController) GetRateLimit(ctx *routing.HTTPContext) error {
+	return r.responder.Send(ctx, r.policy, r.rateLimiter.Snapshot())
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"net/url"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/binding"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+var limitParam = binding.IntParam{Name: "limit", Default: 20, Min: 1, Max: 100, Clamp: true}
+
+type IFeedController interface {
+	GetFeed(ctx *routing.HTTPContext) error
+}
+
+type FeedController struct {
+	feedService services.IFeedService
+	responder   IResponder
+	policy      caching.Policy
+}
+
+func NewFeedController(feedService services.IFeedService, responder IResponder) *FeedController {
+	return &FeedController{
+		feedService: feedService,
+		responder:   responder,
+		policy:      caching.NewPolicy("feed"),
+	}
+}
+
+func (r FeedController) GetFeed(ctx *routing.HTTPContext) error {
+	query := binding.NewQueryBinder(ctx)
+	limit := query.Int(limitParam)
+
+	cursor, err := model.DecodeCursor(query.String("cursor", ""))
+	if err != nil {
+		query.Reject("cursor", "must be a cursor returned by a previous response")
+	}
+
+	if problem := query.Problem(); problem != nil {
+		return binding.WriteProblem(ctx, problem)
+	}
+
+	feedDTO, err := r.feedService.GetFeed(requestContext(ctx), cursor, limit)
+	if err != nil {
+		return err
+	}
+
+	if feedDTO.NextCursor != "" {
+		if requestURL, parseErr := url.Parse(ctx.BaseURL() + ctx.OriginalURL()); parseErr == nil {
+			values := requestURL.Query()
+			values.Set("cursor", feedDTO.NextCursor)
+			requestURL.RawQuery = values.Encode()
+
+			ctx.Set("Link", (&paging.Links{Next: requestURL.String()}).String())
+		}
+	}
+
+	return r.responder.Send(ctx, r.policy, feedDTO)
+}
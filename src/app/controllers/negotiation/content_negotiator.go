@@ -0,0 +1,132 @@
+package negotiation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+	"go.uber.org/dig"
+)
+
+var ErrNotAcceptable = errors.New("not acceptable")
+
+type IContentNegotiator interface {
+	Negotiate(accept string, value any) (ISerializer, error)
+}
+
+type ContentNegotiator struct {
+	serializers []ISerializer
+}
+
+// Serializers are the serializers bound to the "serializers" group.
+type Serializers struct {
+	dig.In
+
+	Serializers []ISerializer `group:"serializers"`
+}
+
+// NewContentNegotiator orders the serializers by the content types listed in
+// negotiation.preference, the first one being used when the client accepts
+// anything. Unlisted content types come last.
+func NewContentNegotiator(serializers Serializers) *ContentNegotiator {
+	preference := strings.Split(config.TryString("negotiation.preference", "application/json,application/xml,application/msgpack,text/csv"), ",")
+	for i := range preference {
+		preference[i] = strings.TrimSpace(preference[i])
+	}
+
+	ordered := slices.Clone(serializers.Serializers)
+	slices.SortFunc(ordered, func(a, b ISerializer) int {
+		if rank, other := preferenceRank(preference, a), preferenceRank(preference, b); rank != other {
+			return rank - other
+		}
+		return strings.Compare(a.ContentType(), b.ContentType())
+	})
+
+	return &ContentNegotiator{
+		serializers: ordered,
+	}
+}
+
+func preferenceRank(preference []string, serializer ISerializer) int {
+	if rank := slices.Index(preference, serializer.ContentType()); rank >= 0 {
+		return rank
+	}
+
+	return len(preference)
+}
+
+func (r *ContentNegotiator) Negotiate(accept string, value any) (ISerializer, error) {
+	for _, mediaRange := range parseAccept(accept) {
+		for _, serializer := range r.serializers {
+			if mediaRange.matches(serializer.ContentType()) && serializer.Supports(value) {
+				return serializer, nil
+			}
+		}
+	}
+
+	var contentTypes []string
+	for _, serializer := range r.serializers {
+		if serializer.Supports(value) {
+			contentTypes = append(contentTypes, serializer.ContentType())
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q, available: %s", ErrNotAcceptable, accept, strings.Join(contentTypes, ", "))
+}
+
+type mediaRange struct {
+	mediaType string
+	subType   string
+	quality   float64
+}
+
+func (r mediaRange) matches(contentType string) bool {
+	mediaType, subType, _ := strings.Cut(contentType, "/")
+
+	return (r.mediaType == "*" || r.mediaType == mediaType) && (r.subType == "*" || r.subType == subType)
+}
+
+func parseAccept(accept string) []mediaRange {
+	if strings.TrimSpace(accept) == "" {
+		return []mediaRange{{mediaType: "*", subType: "*", quality: 1}}
+	}
+
+	var mediaRanges []mediaRange
+	for _, value := range strings.Split(accept, ",") {
+		params := strings.Split(value, ";")
+
+		mediaType, subType, found := strings.Cut(strings.ToLower(strings.TrimSpace(params[0])), "/")
+		if !found {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			key, qValue, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if key != "q" {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(qValue, 64); err == nil {
+				quality = parsed
+			}
+		}
+
+		if quality > 0 {
+			mediaRanges = append(mediaRanges, mediaRange{
+				mediaType: mediaType,
+				subType:   subType,
+				quality:   quality,
+			})
+		}
+	}
+
+	sort.SliceStable(mediaRanges, func(i, j int) bool {
+		return mediaRanges[i].quality > mediaRanges[j].quality
+	})
+
+	return mediaRanges
+}
@@ -0,0 +1,113 @@
+package negotiation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/negotiation"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+)
+
+func pagedResult() *paging.PagedResultDTO[model.UserDTO] {
+	return &paging.PagedResultDTO[model.UserDTO]{
+		Limit: 10,
+		Page:  1,
+		Pages: 1,
+		Total: 2,
+		Results: []model.UserDTO{
+			{
+				ID:   1,
+				Name: "John",
+				Posts: []model.PostDTO{
+					{ID: 1, UserID: 1, Title: "post1", Comments: []model.CommentDTO{{ID: 1, PostID: 1, Body: "comment1"}}},
+					{ID: 2, UserID: 1, Title: "post2", Comments: []model.CommentDTO{}},
+				},
+				Todos: []model.TodoDTO{{ID: 1, UserID: 1, Title: "todo1", DueOn: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Status: "pending"}},
+			},
+			{ID: 2, Name: "Jane", Posts: []model.PostDTO{}, Todos: []model.TodoDTO{}},
+		},
+	}
+}
+
+func newContentNegotiator() *negotiation.ContentNegotiator {
+	return negotiation.NewContentNegotiator(negotiation.Serializers{
+		Serializers: []negotiation.ISerializer{
+			negotiation.NewCSVSerializer(),
+			negotiation.NewMsgPackSerializer(),
+			negotiation.NewXMLSerializer(),
+			negotiation.NewJSONSerializer(),
+		},
+	})
+}
+
+func TestContentNegotiator_Negotiate(t *testing.T) {
+	negotiator := newContentNegotiator()
+
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"", "application/json"},
+		{"*/*", "application/json"},
+		{"application/json", "application/json"},
+		{"application/xml", "application/xml"},
+		{"application/*;q=0.5, text/csv", "text/csv"},
+		{"text/html, application/msgpack;q=0.9, application/xml;q=0.8", "application/msgpack"},
+		{"text/*", "text/csv"},
+	}
+
+	for _, tt := range tests {
+		serializer, err := negotiator.Negotiate(tt.accept, pagedResult())
+		require.NoError(t, err, tt.accept)
+		assert.Equal(t, tt.expected, serializer.ContentType(), tt.accept)
+	}
+}
+
+func TestContentNegotiator_Negotiate_NotAcceptable(t *testing.T) {
+	negotiator := newContentNegotiator()
+
+	_, err := negotiator.Negotiate("text/html", pagedResult())
+	require.ErrorIs(t, err, negotiation.ErrNotAcceptable)
+
+	_, err = negotiator.Negotiate("application/json;q=0", pagedResult())
+	require.ErrorIs(t, err, negotiation.ErrNotAcceptable)
+
+	_, err = negotiator.Negotiate("text/csv", map[string]string{})
+	require.ErrorIs(t, err, negotiation.ErrNotAcceptable)
+}
+
+func TestXMLSerializer_Serialize(t *testing.T) {
+	body, err := negotiation.NewXMLSerializer().Serialize(pagedResult())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "<paged_result><limit>10</limit>")
+	assert.Contains(t, string(body), "<results><result><id>1</id><name>John</name>")
+	assert.Contains(t, string(body), "<comments><comment><id>1</id><post_id>1</post_id>")
+}
+
+func TestMsgPackSerializer_Serialize(t *testing.T) {
+	body, err := negotiation.NewMsgPackSerializer().Serialize(pagedResult())
+	require.NoError(t, err)
+
+	var actual map[string]any
+	require.NoError(t, msgpack.Unmarshal(body, &actual))
+	assert.EqualValues(t, 2, actual["total"])
+	assert.Len(t, actual["results"], 2)
+	assert.NotContains(t, actual, "XMLName")
+}
+
+func TestCSVSerializer_Serialize(t *testing.T) {
+	body, err := negotiation.NewCSVSerializer().Serialize(pagedResult())
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"record,user_id,user_name,user_email,user_gender,user_status,post_id,post_title,post_body,comment_id,comment_name,comment_email,comment_body,todo_id,todo_title,todo_due_on,todo_status\n"+
+		"comment,1,John,,,,1,post1,,1,,,comment1,,,,\n"+
+		"post,1,John,,,,2,post2,,,,,,,,,\n"+
+		"todo,1,John,,,,,,,,,,,1,todo1,2024-01-02T00:00:00Z,pending\n"+
+		"user,2,Jane,,,,,,,,,,,,,,\n", string(body))
+}
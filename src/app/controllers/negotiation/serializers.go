@@ -0,0 +1,124 @@
+package negotiation
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type ISerializer interface {
+	ContentType() string
+	Supports(value any) bool
+	Serialize(value any) ([]byte, error)
+}
+
+type JSONSerializer struct{}
+
+func NewJSONSerializer() *JSONSerializer {
+	return &JSONSerializer{}
+}
+
+func (r JSONSerializer) ContentType() string {
+	return "application/json"
+}
+
+func (r JSONSerializer) Supports(any) bool {
+	return true
+}
+
+func (r JSONSerializer) Serialize(value any) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+type XMLSerializer struct{}
+
+func NewXMLSerializer() *XMLSerializer {
+	return &XMLSerializer{}
+}
+
+func (r XMLSerializer) ContentType() string {
+	return "application/xml"
+}
+
+func (r XMLSerializer) Supports(any) bool {
+	return true
+}
+
+func (r XMLSerializer) Serialize(value any) ([]byte, error) {
+	body, err := xml.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+type MsgPackSerializer struct{}
+
+func NewMsgPackSerializer() *MsgPackSerializer {
+	return &MsgPackSerializer{}
+}
+
+func (r MsgPackSerializer) ContentType() string {
+	return "application/msgpack"
+}
+
+func (r MsgPackSerializer) Supports(any) bool {
+	return true
+}
+
+func (r MsgPackSerializer) Serialize(value any) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	encoder := msgpack.NewEncoder(&buffer)
+	encoder.SetCustomStructTag("json")
+
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// CSVMarshaler is implemented by values that can be flattened into CSV records,
+// header included.
+type CSVMarshaler interface {
+	MarshalCSV() ([][]string, error)
+}
+
+type CSVSerializer struct{}
+
+func NewCSVSerializer() *CSVSerializer {
+	return &CSVSerializer{}
+}
+
+func (r CSVSerializer) ContentType() string {
+	return "text/csv"
+}
+
+func (r CSVSerializer) Supports(value any) bool {
+	_, ok := value.(CSVMarshaler)
+	return ok
+}
+
+func (r CSVSerializer) Serialize(value any) ([]byte, error) {
+	marshaler, ok := value.(CSVMarshaler)
+	if !ok {
+		return nil, ErrNotAcceptable
+	}
+
+	records, err := marshaler.MarshalCSV()
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err = csv.NewWriter(&buffer).WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
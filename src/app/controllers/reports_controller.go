@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+type IReportsController interface {
+	GetOverdueTodos(ctx *routing.HTTPContext) error
+}
+
+type ReportsController struct {
+	reportsService services.IReportsService
+	responder      IResponder
+	policy         caching.Policy
+}
+
+func NewReportsController(reportsService services.IReportsService, responder IResponder) *ReportsController {
+	return &ReportsController{
+		reportsService: reportsService,
+		responder:      responder,
+		policy:         caching.NewPolicy("overdue-todos"),
+	}
+}
+
+func (r ReportsController) GetOverdueTodos(ctx *routing.HTTPContext) error {
+	reportDTO, err := r.reportsService.GetOverdueTodos(requestContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	return r.responder.Send(ctx, r.policy, reportDTO)
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+const (
+	requestTimeoutHeader = "X-Request-Timeout"
+	cacheReportLocal     = "cache-report"
+)
+
+// requestContext is the context handed down to services for an incoming
+// request, each request being its own caller for the upstream bulkheads and
+// carrying the budget asked for in the X-Request-Timeout header, if any. The
+// cache report it collects is left in the request locals for the responder.
+func requestContext(ctx *routing.HTTPContext) context.Context {
+	requestCtx, report := clients.WithCacheReport(clients.WithCaller(ctx.UserContext()))
+	ctx.Locals(cacheReportLocal, report)
+
+	if budget, ok := parseRequestTimeout(ctx.Get(requestTimeoutHeader)); ok {
+		requestCtx = services.WithBudget(requestCtx, budget)
+	}
+
+	return requestCtx
+}
+
+// parseRequestTimeout accepts either milliseconds or a Go duration such as
+// 1500ms or 2s, ignoring anything else.
+func parseRequestTimeout(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	budget, err := time.ParseDuration(value)
+	if err != nil {
+		millis, atoiErr := strconv.Atoi(value)
+		if atoiErr != nil {
+			return 0, false
+		}
+		budget = time.Duration(millis) * time.Millisecond
+	}
+
+	return budget, budget > 0
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"net/http"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/binding"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/negotiation"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+type IResponder interface {
+	Send(ctx *routing.HTTPContext, policy caching.Policy, value any) error
+}
+
+// Responder serializes a value in the representation the client accepts and
+// answers conditional requests for it.
+type Responder struct {
+	negotiator  negotiation.IContentNegotiator
+	conditional caching.IConditional
+}
+
+func NewResponder(negotiator negotiation.IContentNegotiator, conditional caching.IConditional) *Responder {
+	return &Responder{
+		negotiator:  negotiator,
+		conditional: conditional,
+	}
+}
+
+func (r Responder) Send(ctx *routing.HTTPContext, policy caching.Policy, value any) error {
+	ctx.Vary("Accept")
+
+	serializer, err := r.negotiator.Negotiate(ctx.Get("Accept"), value)
+	if err != nil {
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusNotAcceptable, err.Error()))
+	}
+
+	body, err := serializer.Serialize(value)
+	if err != nil {
+		return err
+	}
+
+	result := r.conditional.Evaluate(policy, caching.Request{
+		Key:             ctx.OriginalURL(),
+		ContentType:     serializer.ContentType(),
+		IfNoneMatch:     ctx.Get("If-None-Match"),
+		IfModifiedSince: ctx.Get("If-Modified-Since"),
+	}, body)
+
+	for key, header := range result.Headers {
+		ctx.Set(key, header)
+	}
+
+	if report, ok := ctx.Locals(cacheReportLocal).(*clients.CacheReport); ok && report.Status() != "" {
+		ctx.Set("X-Cache-Status", report.Status())
+		if warning := report.Warning(); warning != "" {
+			ctx.Set("Warning", warning)
+		}
+	}
+
+	if result.NotModified {
+		return ctx.SendStatus(http.StatusNotModified)
+	}
+
+	ctx.Set("Content-Type", serializer.ContentType())
+
+	return ctx.Send(body)
+}
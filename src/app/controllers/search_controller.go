@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/binding"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+type ISearchController interface {
+	Search(ctx *routing.HTTPContext) error
+}
+
+type SearchController struct {
+	searchService services.ISearchService
+	responder     IResponder
+	policy        caching.Policy
+}
+
+func NewSearchController(searchService services.ISearchService, responder IResponder) *SearchController {
+	return &SearchController{
+		searchService: searchService,
+		responder:     responder,
+		policy:        caching.NewPolicy("search"),
+	}
+}
+
+func (r SearchController) Search(ctx *routing.HTTPContext) error {
+	query := binding.NewQueryBinder(ctx)
+	limit := query.Int(limitParam)
+
+	q := strings.TrimSpace(query.String("q", ""))
+	if q == "" {
+		query.Reject("q", "is required")
+	}
+
+	if problem := query.Problem(); problem != nil {
+		return binding.WriteProblem(ctx, problem)
+	}
+
+	searchDTO, err := r.searchService.Search(q, limit)
+	switch {
+	case errors.Is(err, services.ErrSearchDisabled):
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusNotFound, err.Error()))
+	case errors.Is(err, services.ErrSearchIndexPending):
+		ctx.Set("Retry-After", "30")
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusServiceUnavailable, err.Error()))
+	case err != nil:
+		return err
+	}
+
+	return r.responder.Send(ctx, r.policy, searchDTO)
+}
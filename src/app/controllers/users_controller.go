@@ -1,46 +1,133 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/binding"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+
 	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core"
 
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
 	"gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
 )
 
+var pageParam = binding.IntParam{Name: paging.PageParam, Default: 1, Min: 1}
+
+// newPerPageParam reads the per_page bounds from routes.users.per-page.*,
+// clamping values above the maximum.
+func newPerPageParam() binding.IntParam {
+	return binding.IntParam{
+		Name:    paging.PerPageParam,
+		Default: config.TryInt("routes.users.per-page.default", 10),
+		Min:     config.TryInt("routes.users.per-page.min", 1),
+		Max:     config.TryInt("routes.users.per-page.max", 100),
+		Clamp:   true,
+	}
+}
+
 type IUsersController interface {
 	GetUsers(ctx *routing.HTTPContext) error
+	GetUserStats(ctx *routing.HTTPContext) error
 }
 
+// partialPolicy keeps responses missing pending relations out of every cache.
+var partialPolicy = caching.Policy{CacheControl: "no-store"}
+
 type UsersController struct {
 	usersService services.IUsersService
+	responder    IResponder
+	usersPolicy  caching.Policy
+	statsPolicy  caching.Policy
+	perPageParam binding.IntParam
 }
 
-func NewUsersController(usersService services.IUsersService) *UsersController {
+func NewUsersController(usersService services.IUsersService, responder IResponder) *UsersController {
 	return &UsersController{
 		usersService: usersService,
+		responder:    responder,
+		usersPolicy:  caching.NewPolicy("users"),
+		statsPolicy:  caching.NewPolicy("user-stats"),
+		perPageParam: newPerPageParam(),
 	}
 }
 
 func (r UsersController) GetUsers(ctx *routing.HTTPContext) error {
-	pageValue := ctx.Query("page", "1")
-	page, err := strconv.Atoi(pageValue)
-	if err != nil {
-		return core.NewAPIErr(http.StatusBadRequest, err)
+	query := binding.NewQueryBinder(ctx)
+	page := query.Int(pageParam)
+	perPage := query.Int(r.perPageParam)
+	stats := query.Bool("stats", false)
+
+	if problem := query.Problem(); problem != nil {
+		return binding.WriteProblem(ctx, problem)
 	}
 
-	perPageValue := ctx.Query("per_page", "10")
-	perPage, err := strconv.Atoi(perPageValue)
-	if err != nil {
-		return core.NewAPIErr(http.StatusBadRequest, err)
+	if stats {
+		pagedResultDTO, err := r.usersService.GetUsersStats(requestContext(ctx), page, perPage)
+		if err != nil {
+			return err
+		}
+
+		return sendPaged(ctx, r.responder, policyFor(r.statsPolicy, pagedResultDTO.Results...), pagedResultDTO)
 	}
 
-	pagedResultDTO, err := r.usersService.GetUsers(page, perPage)
+	pagedResultDTO, err := r.usersService.GetUsers(requestContext(ctx), page, perPage)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(pagedResultDTO)
+	return sendPaged(ctx, r.responder, policyFor(r.usersPolicy, pagedResultDTO.Results...), pagedResultDTO)
+}
+
+func (r UsersController) GetUserStats(ctx *routing.HTTPContext) error {
+	userID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil || userID < 1 {
+		problem := binding.NewProblem(http.StatusBadRequest, "One or more path parameters are invalid.")
+		problem.InvalidParams = []binding.InvalidParam{{Name: "id", Reason: "must be a positive integer"}}
+
+		return binding.WriteProblem(ctx, problem)
+	}
+
+	userStatsDTO, err := r.usersService.GetUserStats(requestContext(ctx), userID)
+	switch {
+	case errors.Is(err, clients.ErrNotFound):
+		return core.NewAPIErr(http.StatusNotFound, err)
+	case errors.Is(err, services.ErrBudgetExceeded):
+		ctx.Set("Retry-After", "1")
+		return binding.WriteProblem(ctx, binding.NewProblem(http.StatusGatewayTimeout, services.ErrBudgetExceeded.Error()))
+	case err != nil:
+		return err
+	}
+
+	return r.responder.Send(ctx, policyFor(r.statsPolicy, *userStatsDTO), userStatsDTO)
+}
+
+func policyFor[T interface{ Partial() bool }](policy caching.Policy, results ...T) caching.Policy {
+	for _, result := range results {
+		if result.Partial() {
+			return partialPolicy
+		}
+	}
+
+	return policy
+}
+
+func sendPaged[T any](ctx *routing.HTTPContext, responder IResponder, policy caching.Policy, pagedResultDTO *paging.PagedResultDTO[T]) error {
+	requestURL, err := url.Parse(ctx.BaseURL() + ctx.OriginalURL())
+	if err != nil {
+		return core.NewAPIErr(http.StatusBadRequest, err)
+	}
+
+	pagedResultDTO.Links = paging.NewLinks(requestURL, pagedResultDTO.Page, pagedResultDTO.Pages)
+
+	ctx.Set("Link", pagedResultDTO.Links.String())
+	ctx.Set("X-Total-Count", strconv.Itoa(pagedResultDTO.Total))
+
+	return responder.Send(ctx, policy, pagedResultDTO)
 }
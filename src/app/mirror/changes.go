@@ -0,0 +1,325 @@
+package mirror
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/cache"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+var (
+	ErrChangesDisabled      = errors.New("upstream changes are not tracked")
+	ErrChangesExpired       = errors.New("upstream changes are no longer kept")
+	ErrInvalidChangesCursor = errors.New("invalid changes cursor")
+	ErrForeignChangesCursor = errors.New("the cursor belongs to a change log that was started over")
+)
+
+var (
+	changesBucket   = []byte("changes")
+	trackedSinceKey = []byte("tracked-since")
+	changeLogIDKey  = []byte("change-log-id")
+	prunedKey       = []byte("changes-pruned-through")
+)
+
+// Change is an item created, updated or deleted upstream between two syncs,
+// detected at the time the later one read the upstream. Entity is the item as
+// stored, as last seen for deleted items.
+type Change struct {
+	Resource   string    `json:"resource"`
+	ID         int       `json:"id"`
+	Type       string    `json:"type"`
+	DetectedAt time.Time `json:"detected_at"`
+	Entity     []byte    `json:"entity"`
+}
+
+// ChangeLog is a page of the changes, oldest first, Next being the cursor to
+// read the changes after them from and More telling whether there are some
+// already. Since is the time the page was asked from, if it was.
+type ChangeLog struct {
+	Since    time.Time
+	SyncedAt time.Time
+	Changes  []Change
+	Next     string
+	More     bool
+}
+
+// Changes reads up to limit changes, no limit reading them all, after cursor
+// or, without one, detected after since, from the oldest change kept when
+// since is zero. Changes that might have been detected after either but are
+// no longer kept are ErrChangesExpired.
+//
+// A cursor only makes sense to the change log that issued it, and is
+// ErrForeignChangesCursor once the change log was started over, the mirror
+// being lost with the replica that tracks the changes.
+func (r *Store) Changes(since time.Time, cursor string, limit int) (*ChangeLog, error) {
+	if r == nil || !r.tracking {
+		return nil, ErrChangesDisabled
+	}
+
+	changeLog := &ChangeLog{}
+
+	err := r.view(func(tx *bbolt.Tx) error {
+		meta := tx.Bucket(metaBucket)
+
+		data := meta.Get(trackedSinceKey)
+		if data == nil {
+			return ErrNotSynced
+		}
+
+		var trackedSince time.Time
+		if err := trackedSince.UnmarshalBinary(data); err != nil {
+			return err
+		}
+
+		if err := changeLog.SyncedAt.UnmarshalBinary(meta.Get(syncedAtKey)); err != nil {
+			return err
+		}
+
+		logID := string(meta.Get(changeLogIDKey))
+		changes := tx.Bucket(changesBucket)
+
+		// after is the sequence of the last change already read.
+		var after uint64
+
+		if cursor != "" {
+			cursorLogID, seq, err := decodeChangesCursor(cursor)
+			switch {
+			case err != nil || seq > changes.Sequence():
+				return ErrInvalidChangesCursor
+			case cursorLogID != logID:
+				return ErrForeignChangesCursor
+			case seq < sequence(meta.Get(prunedKey)):
+				return fmt.Errorf("%w before %s", ErrChangesExpired, trackedSince.Format(time.RFC3339))
+			}
+
+			after = seq
+		} else {
+			switch {
+			case since.IsZero():
+				since = trackedSince
+			case since.Before(trackedSince):
+				return fmt.Errorf("%w before %s", ErrChangesExpired, trackedSince.Format(time.RFC3339))
+			}
+
+			changeLog.Since = since
+			after = changes.Sequence()
+
+			c := changes.Cursor()
+			for k, data := c.Last(); k != nil; k, data = c.Prev() {
+				var change Change
+				if err := cache.Unmarshal(data, &change); err != nil {
+					return err
+				}
+
+				if !change.DetectedAt.After(since) {
+					break
+				}
+
+				after = sequence(k) - 1
+			}
+		}
+
+		c := changes.Cursor()
+		for k, data := c.Seek(sequenceKey(after + 1)); k != nil; k, data = c.Next() {
+			if limit > 0 && len(changeLog.Changes) == limit {
+				changeLog.More = true
+				break
+			}
+
+			var change Change
+			if err := cache.Unmarshal(data, &change); err != nil {
+				return err
+			}
+
+			changeLog.Changes = append(changeLog.Changes, change)
+			after = sequence(k)
+		}
+
+		changeLog.Next = encodeChangesCursor(logID, after)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changeLog, nil
+}
+
+func encodeChangesCursor(logID string, seq uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(logID + ":" + strconv.FormatUint(seq, 10)))
+}
+
+func decodeChangesCursor(cursor string) (string, uint64, error) {
+	value, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, err
+	}
+
+	logID, seq, found := strings.Cut(string(value), ":")
+	if !found {
+		return "", 0, ErrInvalidChangesCursor
+	}
+
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return logID, n, nil
+}
+
+// sequence decodes the sequence of a change log key, zero for none.
+func sequence(k []byte) uint64 {
+	if len(k) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(k)
+}
+
+func sequenceKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, seq)
+}
+
+// log appends the changes detected at to the change log and forgets the ones
+// past the retention, dropping the change log when changes are not tracked.
+func (r *Store) log(tx *bbolt.Tx, meta *bbolt.Bucket, at time.Time, changes []Change) error {
+	if !r.tracking {
+		if err := tx.DeleteBucket(changesBucket); err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return err
+		}
+
+		for _, k := range [][]byte{trackedSinceKey, changeLogIDKey, prunedKey} {
+			if err := meta.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	changeLog, err := tx.CreateBucketIfNotExists(changesBucket)
+	if err != nil {
+		return err
+	}
+
+	// A change log started over, by this store or another one, is told apart
+	// from the previous one by its ID.
+	if meta.Get(changeLogIDKey) == nil {
+		logID := make([]byte, 8)
+		if _, err = rand.Read(logID); err != nil {
+			return err
+		}
+
+		if err = meta.Put(changeLogIDKey, []byte(hex.EncodeToString(logID))); err != nil {
+			return err
+		}
+	}
+
+	if meta.Get(trackedSinceKey) == nil {
+		// The changes are complete from the snapshot the first tracked one is
+		// compared with, or from the first snapshot when there is none.
+		trackedSince := meta.Get(syncedAtKey)
+		if trackedSince == nil {
+			if trackedSince, err = at.MarshalBinary(); err != nil {
+				return err
+			}
+		}
+
+		if err = meta.Put(trackedSinceKey, trackedSince); err != nil {
+			return err
+		}
+	}
+
+	for i := range changes {
+		changes[i].DetectedAt = at
+
+		seq, err := changeLog.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		data, err := cache.Marshal(changes[i])
+		if err != nil {
+			return err
+		}
+
+		if err = changeLog.Put(sequenceKey(seq), data); err != nil {
+			return err
+		}
+	}
+
+	return r.prune(changeLog, meta, at)
+}
+
+// prune forgets the changes detected retention before at, the change log then
+// being complete only from that time on.
+func (r *Store) prune(changeLog *bbolt.Bucket, meta *bbolt.Bucket, at time.Time) error {
+	if r.retention <= 0 {
+		return nil
+	}
+
+	var trackedSince time.Time
+	if err := trackedSince.UnmarshalBinary(meta.Get(trackedSinceKey)); err != nil {
+		return err
+	}
+
+	cutoff := at.Add(-r.retention)
+	if !cutoff.After(trackedSince) {
+		return nil
+	}
+
+	var (
+		expired       [][]byte
+		prunedThrough uint64
+	)
+
+	cursor := changeLog.Cursor()
+	for k, data := cursor.First(); k != nil; k, data = cursor.Next() {
+		var change Change
+		if err := cache.Unmarshal(data, &change); err != nil {
+			return err
+		}
+
+		if change.DetectedAt.After(cutoff) {
+			break
+		}
+
+		expired = append(expired, k)
+		prunedThrough = sequence(k)
+	}
+
+	for _, k := range expired {
+		if err := changeLog.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	if len(expired) > 0 {
+		if err := meta.Put(prunedKey, sequenceKey(prunedThrough)); err != nil {
+			return err
+		}
+	}
+
+	data, err := cutoff.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return meta.Put(trackedSinceKey, data)
+}
@@ -0,0 +1,191 @@
+package mirror_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+func newTrackingStore(t *testing.T, retention time.Duration) *mirror.Store {
+	store, err := mirror.NewStore(&mirror.Mirroring{
+		Mode:      mirror.ModeLive,
+		Path:      filepath.Join(t.TempDir(), "mirror.db"),
+		Changes:   true,
+		Retention: retention,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	return store
+}
+
+func TestStore_Changes(t *testing.T) {
+	store := newTrackingStore(t, time.Hour)
+
+	baseline := newSnapshot()
+	changes, err := store.Save(baseline)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	changeLog, err := store.Changes(time.Time{}, "", 0)
+	require.NoError(t, err)
+	assert.True(t, changeLog.Since.Equal(baseline.SyncedAt))
+	assert.Empty(t, changeLog.Changes)
+
+	next := newSnapshot()
+	next.SyncedAt = baseline.SyncedAt.Add(time.Minute)
+	next.Users = []model.UserResponse{
+		{ID: 4, Name: "user4"},
+		{ID: 2, Name: "renamed"},
+		{ID: 1, Name: "user1"},
+		{ID: 1, Name: "user1"},
+	}
+
+	changes, err = store.Save(next)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	changeLog, err = store.Changes(baseline.SyncedAt, "", 0)
+	require.NoError(t, err)
+	assert.True(t, changeLog.SyncedAt.Equal(next.SyncedAt))
+	require.Len(t, changeLog.Changes, 3)
+
+	for i, expected := range []struct {
+		id         int
+		changeType string
+	}{
+		{4, mirror.ChangeCreated},
+		{2, mirror.ChangeUpdated},
+		{3, mirror.ChangeDeleted},
+	} {
+		change := changeLog.Changes[i]
+		assert.Equal(t, clients.ResourceUsers, change.Resource)
+		assert.Equal(t, expected.id, change.ID)
+		assert.Equal(t, expected.changeType, change.Type)
+		assert.True(t, change.DetectedAt.Equal(next.SyncedAt))
+		assert.NotEmpty(t, change.Entity)
+	}
+
+	changeLog, err = store.Changes(next.SyncedAt, "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, changeLog.Changes)
+}
+
+func TestStore_Changes_Cursor(t *testing.T) {
+	store := newTrackingStore(t, time.Hour)
+
+	baseline := newSnapshot()
+	_, err := store.Save(baseline)
+	require.NoError(t, err)
+
+	changeLog, err := store.Changes(time.Time{}, "", 2)
+	require.NoError(t, err)
+	assert.Empty(t, changeLog.Changes)
+	assert.False(t, changeLog.More)
+	cursor := changeLog.Next
+
+	next := newSnapshot()
+	next.SyncedAt = baseline.SyncedAt.Add(time.Minute)
+	next.Users = []model.UserResponse{{ID: 4, Name: "user4"}, {ID: 2, Name: "renamed"}, {ID: 1, Name: "user1"}}
+	_, err = store.Save(next)
+	require.NoError(t, err)
+
+	changeLog, err = store.Changes(time.Time{}, cursor, 2)
+	require.NoError(t, err)
+	assert.True(t, changeLog.Since.IsZero())
+	require.Len(t, changeLog.Changes, 2)
+	assert.Equal(t, 4, changeLog.Changes[0].ID)
+	assert.Equal(t, 2, changeLog.Changes[1].ID)
+	assert.True(t, changeLog.More)
+
+	changeLog, err = store.Changes(time.Time{}, changeLog.Next, 2)
+	require.NoError(t, err)
+	require.Len(t, changeLog.Changes, 1)
+	assert.Equal(t, 3, changeLog.Changes[0].ID)
+	assert.False(t, changeLog.More)
+
+	cursor = changeLog.Next
+
+	changeLog, err = store.Changes(time.Time{}, cursor, 2)
+	require.NoError(t, err)
+	assert.Empty(t, changeLog.Changes)
+	assert.Equal(t, cursor, changeLog.Next)
+}
+
+func TestStore_Changes_Cursor_Invalid(t *testing.T) {
+	store := newTrackingStore(t, time.Hour)
+	_, err := store.Save(newSnapshot())
+	require.NoError(t, err)
+
+	other := newTrackingStore(t, time.Hour)
+	_, err = other.Save(newSnapshot())
+	require.NoError(t, err)
+
+	changeLog, err := other.Changes(time.Time{}, "", 0)
+	require.NoError(t, err)
+
+	_, err = store.Changes(time.Time{}, changeLog.Next, 0)
+	require.ErrorIs(t, err, mirror.ErrForeignChangesCursor)
+
+	_, err = store.Changes(time.Time{}, "not a cursor", 0)
+	require.ErrorIs(t, err, mirror.ErrInvalidChangesCursor)
+}
+
+func TestStore_Changes_Expired(t *testing.T) {
+	store := newTrackingStore(t, time.Hour)
+
+	baseline := newSnapshot()
+	_, err := store.Save(baseline)
+	require.NoError(t, err)
+
+	changeLog, err := store.Changes(time.Time{}, "", 0)
+	require.NoError(t, err)
+	cursor := changeLog.Next
+
+	next := newSnapshot()
+	next.SyncedAt = baseline.SyncedAt.Add(time.Minute)
+	next.Todos = nil
+	_, err = store.Save(next)
+	require.NoError(t, err)
+
+	last := newSnapshot()
+	last.SyncedAt = baseline.SyncedAt.Add(2 * time.Hour)
+	_, err = store.Save(last)
+	require.NoError(t, err)
+
+	_, err = store.Changes(baseline.SyncedAt, "", 0)
+	require.ErrorIs(t, err, mirror.ErrChangesExpired)
+
+	_, err = store.Changes(time.Time{}, cursor, 0)
+	require.ErrorIs(t, err, mirror.ErrChangesExpired)
+
+	changeLog, err = store.Changes(time.Time{}, "", 0)
+	require.NoError(t, err)
+	assert.True(t, changeLog.Since.Equal(last.SyncedAt.Add(-time.Hour)))
+	require.Len(t, changeLog.Changes, 1)
+	assert.Equal(t, clients.ResourceTodos, changeLog.Changes[0].Resource)
+	assert.Equal(t, mirror.ChangeCreated, changeLog.Changes[0].Type)
+}
+
+func TestStore_Changes_Disabled(t *testing.T) {
+	_, err := newStore(t).Changes(time.Time{}, "", 0)
+	require.ErrorIs(t, err, mirror.ErrChangesDisabled)
+
+	var store *mirror.Store
+	_, err = store.Changes(time.Time{}, "", 0)
+	require.ErrorIs(t, err, mirror.ErrChangesDisabled)
+}
+
+func TestStore_Changes_NotSynced(t *testing.T) {
+	_, err := newTrackingStore(t, 0).Changes(time.Time{}, "", 0)
+	require.ErrorIs(t, err, mirror.ErrNotSynced)
+}
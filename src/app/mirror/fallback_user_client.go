@@ -0,0 +1,138 @@
+package mirror
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	log "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-logger"
+)
+
+var mirrorFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "gorest_api",
+	Subsystem: "mirror",
+	Name:      "fallbacks_total",
+	Help:      "Failed upstream reads answered from the mirror, by resource and outcome.",
+}, []string{"resource", "outcome"})
+
+// NewSource picks the IUserClient the services read the upstream data from,
+// by mirror.mode.
+func NewSource(live *clients.CachedUserClient, store *Store, mirroring *Mirroring) clients.IUserClient {
+	switch mirroring.Mode {
+	case ModeMirrored:
+		return NewMirroredUserClient(store)
+	case ModeFallback:
+		return NewFallbackUserClient(live, NewMirroredUserClient(store))
+	default:
+		return live
+	}
+}
+
+// FallbackUserClient reads from the upstream, answering from the mirror when
+// the upstream fails. Missing data is not a failure, and the upstream error is
+// kept when the mirror cannot answer either.
+type FallbackUserClient struct {
+	live     clients.IUserClient
+	mirrored clients.IUserClient
+}
+
+func NewFallbackUserClient(live clients.IUserClient, mirrored clients.IUserClient) *FallbackUserClient {
+	return &FallbackUserClient{
+		live:     live,
+		mirrored: mirrored,
+	}
+}
+
+func (c *FallbackUserClient) GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error) {
+	return fallback(ctx, c, clients.ResourceUsers, func(client clients.IUserClient) (*paging.PagedResultResponse[model.UserResponse], error) {
+		return client.GetUsers(ctx, page, perPage)
+	})
+}
+
+func (c *FallbackUserClient) GetUser(ctx context.Context, userID int) (*model.UserResponse, error) {
+	return fallback(ctx, c, clients.ResourceUsers, func(client clients.IUserClient) (*model.UserResponse, error) {
+		return client.GetUser(ctx, userID)
+	})
+}
+
+func (c *FallbackUserClient) GetAllPosts(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.PostResponse], error) {
+	return fallback(ctx, c, clients.ResourcePosts, func(client clients.IUserClient) (*paging.PagedResultResponse[model.PostResponse], error) {
+		return client.GetAllPosts(ctx, page, perPage)
+	})
+}
+
+func (c *FallbackUserClient) GetPost(ctx context.Context, postID int) (*model.PostResponse, error) {
+	return fallback(ctx, c, clients.ResourcePosts, func(client clients.IUserClient) (*model.PostResponse, error) {
+		return client.GetPost(ctx, postID)
+	})
+}
+
+func (c *FallbackUserClient) GetAllComments(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	return fallback(ctx, c, clients.ResourceComments, func(client clients.IUserClient) (*paging.PagedResultResponse[model.CommentResponse], error) {
+		return client.GetAllComments(ctx, page, perPage)
+	})
+}
+
+func (c *FallbackUserClient) GetCommentsByEmail(ctx context.Context, email string, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	return fallback(ctx, c, clients.ResourceComments, func(client clients.IUserClient) (*paging.PagedResultResponse[model.CommentResponse], error) {
+		return client.GetCommentsByEmail(ctx, email, page, perPage)
+	})
+}
+
+func (c *FallbackUserClient) GetAllTodos(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.TodoResponse], error) {
+	return fallback(ctx, c, clients.ResourceTodos, func(client clients.IUserClient) (*paging.PagedResultResponse[model.TodoResponse], error) {
+		return client.GetAllTodos(ctx, page, perPage)
+	})
+}
+
+func (c *FallbackUserClient) GetComment(ctx context.Context, commentID int) (*model.CommentResponse, error) {
+	return fallback(ctx, c, clients.ResourceComments, func(client clients.IUserClient) (*model.CommentResponse, error) {
+		return client.GetComment(ctx, commentID)
+	})
+}
+
+func (c *FallbackUserClient) GetTodo(ctx context.Context, todoID int) (*model.TodoResponse, error) {
+	return fallback(ctx, c, clients.ResourceTodos, func(client clients.IUserClient) (*model.TodoResponse, error) {
+		return client.GetTodo(ctx, todoID)
+	})
+}
+
+func (c *FallbackUserClient) GetPosts(ctx context.Context, userID int) ([]model.PostResponse, error) {
+	return fallback(ctx, c, clients.ResourcePosts, func(client clients.IUserClient) ([]model.PostResponse, error) {
+		return client.GetPosts(ctx, userID)
+	})
+}
+
+func (c *FallbackUserClient) GetTodos(ctx context.Context, userID int) ([]model.TodoResponse, error) {
+	return fallback(ctx, c, clients.ResourceTodos, func(client clients.IUserClient) ([]model.TodoResponse, error) {
+		return client.GetTodos(ctx, userID)
+	})
+}
+
+func (c *FallbackUserClient) GetComments(ctx context.Context, postID int) ([]model.CommentResponse, error) {
+	return fallback(ctx, c, clients.ResourceComments, func(client clients.IUserClient) ([]model.CommentResponse, error) {
+		return client.GetComments(ctx, postID)
+	})
+}
+
+func fallback[T any](ctx context.Context, c *FallbackUserClient, resource string, read func(client clients.IUserClient) (T, error)) (T, error) {
+	value, err := read(c.live)
+	if err == nil || errors.Is(err, clients.ErrNotFound) || ctx.Err() != nil {
+		return value, err
+	}
+
+	mirrored, mirrorErr := read(c.mirrored)
+	if mirrorErr != nil {
+		mirrorFallbacks.WithLabelValues(resource, "failed").Inc()
+		log.Warnf("falling back to the mirror for %s failed: %v", resource, mirrorErr)
+		return value, err
+	}
+
+	mirrorFallbacks.WithLabelValues(resource, "served").Inc()
+
+	return mirrored, nil
+}
@@ -0,0 +1,66 @@
+package mirror_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+)
+
+var errUpstream = errors.New("upstream unavailable")
+
+func TestFallbackUserClient(t *testing.T) {
+	live := mocks.NewMockIUserClient(t)
+	mirrored := mocks.NewMockIUserClient(t)
+
+	live.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "live"}, nil).Once()
+	live.EXPECT().GetUser(mock.Anything, 1).Return(nil, errUpstream).Once()
+	mirrored.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "mirrored"}, nil).Once()
+
+	client := mirror.NewFallbackUserClient(live, mirrored)
+
+	actual, err := client.GetUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "live", actual.Name)
+
+	actual, err = client.GetUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "mirrored", actual.Name)
+}
+
+func TestFallbackUserClient_NotFound(t *testing.T) {
+	live := mocks.NewMockIUserClient(t)
+	mirrored := mocks.NewMockIUserClient(t)
+
+	live.EXPECT().GetPost(mock.Anything, 1).Return(nil, fmt.Errorf("%w: post 1", clients.ErrNotFound))
+
+	_, err := mirror.NewFallbackUserClient(live, mirrored).GetPost(context.Background(), 1)
+	require.ErrorIs(t, err, clients.ErrNotFound)
+}
+
+func TestFallbackUserClient_MirrorFails(t *testing.T) {
+	live := mocks.NewMockIUserClient(t)
+	mirrored := mocks.NewMockIUserClient(t)
+
+	live.EXPECT().GetTodos(mock.Anything, 1).Return(nil, errUpstream)
+	mirrored.EXPECT().GetTodos(mock.Anything, 1).Return(nil, mirror.ErrNotSynced)
+
+	_, err := mirror.NewFallbackUserClient(live, mirrored).GetTodos(context.Background(), 1)
+	require.ErrorIs(t, err, errUpstream)
+}
+
+func TestNewSource(t *testing.T) {
+	store := newStore(t)
+
+	assert.IsType(t, &clients.CachedUserClient{}, mirror.NewSource(&clients.CachedUserClient{}, nil, &mirror.Mirroring{Mode: mirror.ModeLive}))
+	assert.IsType(t, &mirror.MirroredUserClient{}, mirror.NewSource(&clients.CachedUserClient{}, store, &mirror.Mirroring{Mode: mirror.ModeMirrored}))
+	assert.IsType(t, &mirror.FallbackUserClient{}, mirror.NewSource(&clients.CachedUserClient{}, store, &mirror.Mirroring{Mode: mirror.ModeFallback}))
+}
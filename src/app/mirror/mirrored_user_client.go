@@ -0,0 +1,71 @@
+package mirror
+
+import (
+	"context"
+	"strings"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+)
+
+// MirroredUserClient reads the upstream data from the last snapshot synced to
+// the store, paging and ordering it the way the upstream does.
+type MirroredUserClient struct {
+	store *Store
+}
+
+func NewMirroredUserClient(store *Store) *MirroredUserClient {
+	return &MirroredUserClient{
+		store: store,
+	}
+}
+
+func (c *MirroredUserClient) GetUsers(_ context.Context, page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error) {
+	return list(c.store, users, nil, page, perPage)
+}
+
+func (c *MirroredUserClient) GetUser(_ context.Context, userID int) (*model.UserResponse, error) {
+	return get(c.store, users, userID)
+}
+
+func (c *MirroredUserClient) GetAllPosts(_ context.Context, page int, perPage int) (*paging.PagedResultResponse[model.PostResponse], error) {
+	return list(c.store, posts, nil, page, perPage)
+}
+
+func (c *MirroredUserClient) GetPost(_ context.Context, postID int) (*model.PostResponse, error) {
+	return get(c.store, posts, postID)
+}
+
+func (c *MirroredUserClient) GetAllComments(_ context.Context, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	return list(c.store, comments, nil, page, perPage)
+}
+
+func (c *MirroredUserClient) GetCommentsByEmail(_ context.Context, email string, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	return list(c.store, comments, func(comment model.CommentResponse) bool {
+		return strings.EqualFold(comment.Email, email)
+	}, page, perPage)
+}
+
+func (c *MirroredUserClient) GetAllTodos(_ context.Context, page int, perPage int) (*paging.PagedResultResponse[model.TodoResponse], error) {
+	return list(c.store, todos, nil, page, perPage)
+}
+
+func (c *MirroredUserClient) GetComment(_ context.Context, commentID int) (*model.CommentResponse, error) {
+	return get(c.store, comments, commentID)
+}
+
+func (c *MirroredUserClient) GetTodo(_ context.Context, todoID int) (*model.TodoResponse, error) {
+	return get(c.store, todos, todoID)
+}
+
+func (c *MirroredUserClient) GetPosts(_ context.Context, userID int) ([]model.PostResponse, error) {
+	return children(c.store, posts, userID)
+}
+
+func (c *MirroredUserClient) GetTodos(_ context.Context, userID int) ([]model.TodoResponse, error) {
+	return children(c.store, todos, userID)
+}
+
+func (c *MirroredUserClient) GetComments(_ context.Context, postID int) ([]model.CommentResponse, error) {
+	return children(c.store, comments, postID)
+}
@@ -0,0 +1,135 @@
+package mirror_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+func newStore(t *testing.T) *mirror.Store {
+	store, err := mirror.OpenStore(filepath.Join(t.TempDir(), "mirror", "mirror.db"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	return store
+}
+
+func newSnapshot() *mirror.Snapshot {
+	return &mirror.Snapshot{
+		SyncedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		Users: []model.UserResponse{
+			{ID: 1, Name: "user1"},
+			{ID: 2, Name: "user2"},
+			{ID: 3, Name: "user3"},
+		},
+		Posts: []model.PostResponse{
+			{ID: 10, UserID: 1, Title: "post10"},
+			{ID: 11, UserID: 1, Title: "post11"},
+			{ID: 12, UserID: 2, Title: "post12"},
+		},
+		Comments: []model.CommentResponse{
+			{ID: 100, PostID: 10, Email: "Reader@example.com"},
+			{ID: 101, PostID: 10, Email: "other@example.com"},
+			{ID: 102, PostID: 11, Email: "reader@example.com"},
+		},
+		Todos: []model.TodoResponse{
+			{ID: 1000, UserID: 1, Title: "todo1000", DueOn: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+}
+
+func TestMirroredUserClient(t *testing.T) {
+	store := newStore(t)
+	_, err := store.Save(newSnapshot())
+	require.NoError(t, err)
+
+	client := mirror.NewMirroredUserClient(store)
+	ctx := context.Background()
+
+	syncedAt, err := store.SyncedAt()
+	require.NoError(t, err)
+	assert.True(t, syncedAt.Equal(newSnapshot().SyncedAt))
+
+	pagedUsers, err := client.GetUsers(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pagedUsers.Limit)
+	assert.Equal(t, 2, pagedUsers.Pages)
+	assert.Equal(t, 3, pagedUsers.Total)
+	assert.Equal(t, []model.UserResponse{{ID: 3, Name: "user3"}, {ID: 2, Name: "user2"}}, pagedUsers.Results)
+
+	pagedUsers, err = client.GetUsers(ctx, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []model.UserResponse{{ID: 1, Name: "user1"}}, pagedUsers.Results)
+
+	user, err := client.GetUser(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "user2", user.Name)
+
+	_, err = client.GetUser(ctx, 4)
+	require.ErrorIs(t, err, clients.ErrNotFound)
+
+	userPosts, err := client.GetPosts(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, userPosts, 2)
+	assert.Equal(t, 11, userPosts[0].ID)
+	assert.Equal(t, 10, userPosts[1].ID)
+
+	userPosts, err = client.GetPosts(ctx, 3)
+	require.NoError(t, err)
+	assert.Empty(t, userPosts)
+
+	postComments, err := client.GetComments(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, postComments, 2)
+
+	pagedComments, err := client.GetCommentsByEmail(ctx, "READER@example.com", 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pagedComments.Total)
+	assert.Equal(t, 102, pagedComments.Results[0].ID)
+
+	userTodos, err := client.GetTodos(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, userTodos, 1)
+	assert.True(t, userTodos[0].DueOn.Equal(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestMirroredUserClient_Replace(t *testing.T) {
+	store := newStore(t)
+	_, err := store.Save(newSnapshot())
+	require.NoError(t, err)
+
+	snapshot := newSnapshot()
+	snapshot.Posts = snapshot.Posts[1:]
+	_, err = store.Save(snapshot)
+	require.NoError(t, err)
+
+	client := mirror.NewMirroredUserClient(store)
+
+	_, err = client.GetPost(context.Background(), 10)
+	require.ErrorIs(t, err, clients.ErrNotFound)
+
+	userPosts, err := client.GetPosts(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, userPosts, 1)
+	assert.Equal(t, 11, userPosts[0].ID)
+}
+
+func TestMirroredUserClient_NotSynced(t *testing.T) {
+	client := mirror.NewMirroredUserClient(newStore(t))
+
+	_, err := client.GetUsers(context.Background(), 1, 10)
+	require.ErrorIs(t, err, mirror.ErrNotSynced)
+
+	_, err = client.GetPosts(context.Background(), 1)
+	require.ErrorIs(t, err, mirror.ErrNotSynced)
+}
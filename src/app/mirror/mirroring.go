@@ -0,0 +1,71 @@
+package mirror
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+const (
+	ModeLive     = "live"
+	ModeMirrored = "mirrored"
+	ModeFallback = "fallback"
+)
+
+// Mirroring is where the upstream data is read from: gorest itself, the local
+// mirror of it, or gorest falling back to the mirror when it fails. The mirror
+// is kept in sync outside of the live mode, or to track the upstream changes
+// for Retention. Every replica would track the changes in its own mirror, so
+// they are tracked by the single replica of the changes deployment, the one
+// /changes is routed to, which turns them on with MIRROR_CHANGES_ENABLED.
+type Mirroring struct {
+	Mode      string
+	Path      string
+	Interval  time.Duration
+	PerPage   int
+	Changes   bool
+	Retention time.Duration
+
+	ConfirmDeletions int
+}
+
+func NewMirroring() (*Mirroring, error) {
+	mirroring := &Mirroring{
+		Mode:      config.TryString("mirror.mode", ModeLive),
+		Path:      config.TryString("mirror.path", filepath.Join(os.TempDir(), "gorest-api", "mirror.db")),
+		Interval:  time.Duration(config.TryInt("mirror.sync-interval", 300000)) * time.Millisecond,
+		PerPage:   config.TryInt("mirror.per-page", 100),
+		Changes:   changesEnabled(),
+		Retention: time.Duration(config.TryInt("mirror.changes.retention", 86400000)) * time.Millisecond,
+
+		ConfirmDeletions: config.TryInt("mirror.confirm-deletions.max", 50),
+	}
+
+	switch mirroring.Mode {
+	case ModeLive, ModeMirrored, ModeFallback:
+		return mirroring, nil
+	default:
+		return nil, fmt.Errorf("mirror.mode must be one of %s, %s or %s, got %q", ModeLive, ModeMirrored, ModeFallback, mirroring.Mode)
+	}
+}
+
+// changesEnabled reads mirror.changes.enabled, overridden by the
+// MIRROR_CHANGES_ENABLED environment variable of the replica, the config being
+// shared by every deployment.
+func changesEnabled() bool {
+	if enabled, err := strconv.ParseBool(os.Getenv("MIRROR_CHANGES_ENABLED")); err == nil {
+		return enabled
+	}
+
+	return config.TryBool("mirror.changes.enabled", false)
+}
+
+// Enabled tells whether the mirror is read from or tracks changes, and so is
+// kept in sync.
+func (r *Mirroring) Enabled() bool {
+	return r.Mode != ModeLive || r.Changes
+}
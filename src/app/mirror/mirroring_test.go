@@ -0,0 +1,28 @@
+package mirror
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMirroring_ChangesEnabled(t *testing.T) {
+	t.Setenv("MIRROR_CHANGES_ENABLED", "true")
+
+	mirroring, err := NewMirroring()
+	require.NoError(t, err)
+
+	assert.True(t, mirroring.Changes)
+	assert.True(t, mirroring.Enabled())
+}
+
+func TestNewMirroring_ChangesDisabled(t *testing.T) {
+	t.Setenv("MIRROR_CHANGES_ENABLED", "")
+
+	mirroring, err := NewMirroring()
+	require.NoError(t, err)
+
+	assert.False(t, mirroring.Changes)
+	assert.False(t, mirroring.Enabled())
+}
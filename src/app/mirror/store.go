@@ -0,0 +1,416 @@
+package mirror
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/cache"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"go.etcd.io/bbolt"
+)
+
+const defaultPerPage = 10
+
+var ErrNotSynced = errors.New("the mirror has not been synced yet")
+
+var (
+	metaBucket  = []byte("meta")
+	syncedAtKey = []byte("synced-at")
+)
+
+// Snapshot is the whole upstream data as read at SyncedAt.
+type Snapshot struct {
+	SyncedAt time.Time
+	Users    []model.UserResponse
+	Posts    []model.PostResponse
+	Comments []model.CommentResponse
+	Todos    []model.TodoResponse
+}
+
+// table is how a resource is laid out in the store: a bucket of items by ID,
+// and a bucket of parent and item ID pairs to find the items of a parent.
+type table[T any] struct {
+	name   string
+	kind   string
+	id     func(T) int
+	parent func(T) int
+}
+
+func (t table[T]) items() []byte {
+	return []byte(t.name)
+}
+
+func (t table[T]) index() []byte {
+	return []byte(t.name + "-by-parent")
+}
+
+var (
+	users = table[model.UserResponse]{
+		name: clients.ResourceUsers,
+		kind: "user",
+		id:   func(r model.UserResponse) int { return r.ID },
+	}
+	posts = table[model.PostResponse]{
+		name:   clients.ResourcePosts,
+		kind:   "post",
+		id:     func(r model.PostResponse) int { return r.ID },
+		parent: func(r model.PostResponse) int { return r.UserID },
+	}
+	comments = table[model.CommentResponse]{
+		name:   clients.ResourceComments,
+		kind:   "comment",
+		id:     func(r model.CommentResponse) int { return r.ID },
+		parent: func(r model.CommentResponse) int { return r.PostID },
+	}
+	todos = table[model.TodoResponse]{
+		name:   clients.ResourceTodos,
+		kind:   "todo",
+		id:     func(r model.TodoResponse) int { return r.ID },
+		parent: func(r model.TodoResponse) int { return r.UserID },
+	}
+)
+
+// Store keeps the last snapshot of the upstream data in an embedded bbolt
+// database, replacing it as a whole on every sync and, when tracking changes,
+// logging what each sync created, updated or deleted.
+type Store struct {
+	db        *bbolt.DB
+	tracking  bool
+	retention time.Duration
+}
+
+// NewStore opens the store at mirror.path, there being no store in the live
+// mode unless changes are tracked.
+func NewStore(mirroring *Mirroring) (*Store, error) {
+	if !mirroring.Enabled() {
+		return nil, nil
+	}
+
+	store, err := OpenStore(mirroring.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	store.tracking = mirroring.Changes
+	store.retention = mirroring.Retention
+
+	return store, nil
+}
+
+func OpenStore(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening the mirror at %s: %w", path, err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (r *Store) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	return r.db.Close()
+}
+
+// Save replaces the mirrored data with snapshot in a single transaction, so
+// that readers see either snapshot or the previous one, returning the changes
+// logged for it. The first snapshot is only the baseline of later changes.
+func (r *Store) Save(snapshot *Snapshot) ([]Change, error) {
+	var changes []Change
+
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		meta, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return err
+		}
+
+		track := r.tracking && meta.Get(syncedAtKey) != nil
+
+		var changed []Change
+		if changed, err = replace(tx, users, snapshot.Users, track); err != nil {
+			return err
+		}
+		changes = append(changes, changed...)
+
+		if changed, err = replace(tx, posts, snapshot.Posts, track); err != nil {
+			return err
+		}
+		changes = append(changes, changed...)
+
+		if changed, err = replace(tx, comments, snapshot.Comments, track); err != nil {
+			return err
+		}
+		changes = append(changes, changed...)
+
+		if changed, err = replace(tx, todos, snapshot.Todos, track); err != nil {
+			return err
+		}
+		changes = append(changes, changed...)
+
+		if err = r.log(tx, meta, snapshot.SyncedAt, changes); err != nil {
+			return err
+		}
+
+		syncedAt, err := snapshot.SyncedAt.MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		return meta.Put(syncedAtKey, syncedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// SyncedAt is when the mirrored snapshot was read from the upstream.
+func (r *Store) SyncedAt() (time.Time, error) {
+	var syncedAt time.Time
+
+	err := r.view(func(tx *bbolt.Tx) error {
+		return syncedAt.UnmarshalBinary(tx.Bucket(metaBucket).Get(syncedAtKey))
+	})
+
+	return syncedAt, err
+}
+
+func (r *Store) view(f func(tx *bbolt.Tx) error) error {
+	return r.db.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket(metaBucket) == nil {
+			return ErrNotSynced
+		}
+
+		return f(tx)
+	})
+}
+
+// replace swaps the items of t for values, telling apart the items created,
+// updated and deleted since the previous values when tracking changes.
+func replace[T any](tx *bbolt.Tx, t table[T], values []T, track bool) ([]Change, error) {
+	previous := make(map[int][]byte)
+	if current := tx.Bucket(t.items()); track && current != nil {
+		err := current.ForEach(func(k []byte, data []byte) error {
+			previous[int(binary.BigEndian.Uint64(k))] = bytes.Clone(data)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range [][]byte{t.items(), t.index()} {
+		if err := tx.DeleteBucket(name); err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return nil, err
+		}
+	}
+
+	items, err := tx.CreateBucket(t.items())
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := tx.CreateBucket(t.index())
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	saved := make(map[int]bool, len(values))
+
+	for _, value := range values {
+		// Items moving across pages while the upstream is read show up twice.
+		id := t.id(value)
+		if saved[id] {
+			continue
+		}
+		saved[id] = true
+
+		data, err := cache.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = items.Put(key(id), data); err != nil {
+			return nil, err
+		}
+
+		if t.parent != nil {
+			if err = index.Put(append(key(t.parent(value)), key(id)...), []byte{}); err != nil {
+				return nil, err
+			}
+		}
+
+		if !track {
+			continue
+		}
+
+		before, found := previous[id]
+		switch {
+		case !found:
+			changes = append(changes, Change{Resource: t.name, ID: id, Type: ChangeCreated, Entity: data})
+		case !bytes.Equal(before, data):
+			changes = append(changes, Change{Resource: t.name, ID: id, Type: ChangeUpdated, Entity: data})
+		}
+		delete(previous, id)
+	}
+
+	deleted := make([]int, 0, len(previous))
+	for id := range previous {
+		deleted = append(deleted, id)
+	}
+	slices.Sort(deleted)
+
+	for _, id := range deleted {
+		changes = append(changes, Change{Resource: t.name, ID: id, Type: ChangeDeleted, Entity: previous[id]})
+	}
+
+	return changes, nil
+}
+
+// missing lists the IDs of the items of t stored but not in values, none
+// before the first snapshot.
+func missing[T any](r *Store, t table[T], values []T) ([]int, error) {
+	ids := make(map[int]bool, len(values))
+	for _, value := range values {
+		ids[t.id(value)] = true
+	}
+
+	var missingIDs []int
+
+	err := r.view(func(tx *bbolt.Tx) error {
+		items := tx.Bucket(t.items())
+		if items == nil {
+			return nil
+		}
+
+		return items.ForEach(func(k []byte, _ []byte) error {
+			if id := int(binary.BigEndian.Uint64(k)); !ids[id] {
+				missingIDs = append(missingIDs, id)
+			}
+			return nil
+		})
+	})
+	if errors.Is(err, ErrNotSynced) {
+		return nil, nil
+	}
+
+	return missingIDs, err
+}
+
+func get[T any](r *Store, t table[T], id int) (*T, error) {
+	value := new(T)
+
+	err := r.view(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(t.items()).Get(key(id))
+		if data == nil {
+			return fmt.Errorf("%w: %s %d", clients.ErrNotFound, t.kind, id)
+		}
+
+		return cache.Unmarshal(data, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// list pages through the items kept by keep, newest first as the upstream
+// does, keep being nil to page through every item.
+func list[T any](r *Store, t table[T], keep func(T) bool, page int, perPage int) (*paging.PagedResultResponse[T], error) {
+	page = max(page, 1)
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	pagedResult := &paging.PagedResultResponse[T]{
+		Limit: perPage,
+		Page:  page,
+	}
+
+	first := (page - 1) * perPage
+
+	err := r.view(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(t.items()).Cursor()
+		for k, data := cursor.Last(); k != nil; k, data = cursor.Prev() {
+			inPage := pagedResult.Total >= first && len(pagedResult.Results) < perPage
+
+			if keep != nil || inPage {
+				var value T
+				if err := cache.Unmarshal(data, &value); err != nil {
+					return err
+				}
+
+				if keep != nil && !keep(value) {
+					continue
+				}
+
+				if inPage {
+					pagedResult.Results = append(pagedResult.Results, value)
+				}
+			}
+
+			pagedResult.Total++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pagedResult.Pages = (pagedResult.Total + perPage - 1) / perPage
+
+	return pagedResult, nil
+}
+
+// children are the items of a parent, newest first as the upstream returns
+// them.
+func children[T any](r *Store, t table[T], parentID int) ([]T, error) {
+	var values []T
+
+	err := r.view(func(tx *bbolt.Tx) error {
+		items := tx.Bucket(t.items())
+		prefix := key(parentID)
+
+		cursor := tx.Bucket(t.index()).Cursor()
+		for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+			var value T
+			if err := cache.Unmarshal(items.Get(k[len(prefix):]), &value); err != nil {
+				return err
+			}
+
+			values = append(values, value)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(values)
+
+	return values, nil
+}
+
+// key encodes an ID so that keys sort by ID.
+func key(id int) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(id))
+}
@@ -0,0 +1,235 @@
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	log "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-logger"
+)
+
+var (
+	mirrorSyncs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gorest_api",
+		Subsystem: "mirror",
+		Name:      "syncs_total",
+		Help:      "Mirror syncs, by outcome.",
+	}, []string{"outcome"})
+	mirrorSynced = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "mirror",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix time of the upstream read behind the mirrored snapshot.",
+	})
+	mirrorEntities = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "mirror",
+		Name:      "entities",
+		Help:      "Entities in the mirrored snapshot, by resource.",
+	}, []string{"resource"})
+	mirrorChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gorest_api",
+		Subsystem: "mirror",
+		Name:      "changes_total",
+		Help:      "Upstream changes detected by the syncs, by resource and type.",
+	}, []string{"resource", "type"})
+)
+
+type ISyncer interface {
+	Start(ctx context.Context)
+	Stop()
+}
+
+// Syncer reads every user, post, comment and todo from the upstream every
+// interval, replacing the mirrored snapshot once all of them were read and
+// so detecting what changed upstream since the previous sync. Items missing
+// from the read are only taken as deleted once the upstream confirms it, up to
+// ConfirmDeletions of them a sync.
+type Syncer struct {
+	client    clients.IUserClient
+	store     *Store
+	mirroring *Mirroring
+	now       func() time.Time
+
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewSyncer(client *clients.UserClient, store *Store, mirroring *Mirroring) *Syncer {
+	return newSyncer(client, store, mirroring)
+}
+
+func newSyncer(client clients.IUserClient, store *Store, mirroring *Mirroring) *Syncer {
+	return &Syncer{
+		client:    client,
+		store:     store,
+		mirroring: mirroring,
+		now:       time.Now,
+	}
+}
+
+func (r *Syncer) Start(ctx context.Context) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if !r.mirroring.Enabled() || r.cancel != nil {
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.mirroring.Interval)
+		defer ticker.Stop()
+
+		for {
+			r.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop cancels the sync in progress, keeping the last snapshot, and waits for
+// the syncer to return.
+func (r *Syncer) Stop() {
+	r.mtx.Lock()
+	cancel, done := r.cancel, r.done
+	r.mtx.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+func (r *Syncer) run(ctx context.Context) {
+	snapshot, err := r.Sync(ctx)
+	switch {
+	case err == nil:
+		mirrorSyncs.WithLabelValues("ok").Inc()
+		mirrorSynced.Set(float64(snapshot.SyncedAt.Unix()))
+		mirrorEntities.WithLabelValues(clients.ResourceUsers).Set(float64(len(snapshot.Users)))
+		mirrorEntities.WithLabelValues(clients.ResourcePosts).Set(float64(len(snapshot.Posts)))
+		mirrorEntities.WithLabelValues(clients.ResourceComments).Set(float64(len(snapshot.Comments)))
+		mirrorEntities.WithLabelValues(clients.ResourceTodos).Set(float64(len(snapshot.Todos)))
+	case ctx.Err() != nil:
+		mirrorSyncs.WithLabelValues("canceled").Inc()
+	default:
+		mirrorSyncs.WithLabelValues("error").Inc()
+		log.Warnf("syncing the mirror failed: %v", err)
+	}
+}
+
+// Sync reads a snapshot of the upstream and saves it to the store.
+func (r *Syncer) Sync(ctx context.Context) (*Snapshot, error) {
+	ctx = clients.WithCaller(ctx)
+
+	snapshot := &Snapshot{SyncedAt: r.now()}
+	confirmations := r.mirroring.ConfirmDeletions
+
+	var err error
+	if snapshot.Users, err = readAll(ctx, r.client.GetUsers, r.mirroring.PerPage); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", clients.ResourceUsers, err)
+	}
+	if snapshot.Posts, err = readAll(ctx, r.client.GetAllPosts, r.mirroring.PerPage); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", clients.ResourcePosts, err)
+	}
+	if snapshot.Comments, err = readAll(ctx, r.client.GetAllComments, r.mirroring.PerPage); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", clients.ResourceComments, err)
+	}
+	if snapshot.Todos, err = readAll(ctx, r.client.GetAllTodos, r.mirroring.PerPage); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", clients.ResourceTodos, err)
+	}
+
+	if snapshot.Users, err = confirmDeleted(ctx, r.store, users, snapshot.Users, r.client.GetUser, &confirmations); err != nil {
+		return nil, fmt.Errorf("confirming the deleted %s: %w", clients.ResourceUsers, err)
+	}
+	if snapshot.Posts, err = confirmDeleted(ctx, r.store, posts, snapshot.Posts, r.client.GetPost, &confirmations); err != nil {
+		return nil, fmt.Errorf("confirming the deleted %s: %w", clients.ResourcePosts, err)
+	}
+	if snapshot.Comments, err = confirmDeleted(ctx, r.store, comments, snapshot.Comments, r.client.GetComment, &confirmations); err != nil {
+		return nil, fmt.Errorf("confirming the deleted %s: %w", clients.ResourceComments, err)
+	}
+	if snapshot.Todos, err = confirmDeleted(ctx, r.store, todos, snapshot.Todos, r.client.GetTodo, &confirmations); err != nil {
+		return nil, fmt.Errorf("confirming the deleted %s: %w", clients.ResourceTodos, err)
+	}
+
+	changes, err := r.store.Save(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("saving the snapshot: %w", err)
+	}
+
+	for _, change := range changes {
+		mirrorChanges.WithLabelValues(change.Resource, change.Type).Inc()
+	}
+
+	return snapshot, nil
+}
+
+func readAll[T any](ctx context.Context, read func(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[T], error), perPage int) ([]T, error) {
+	var values []T
+
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		pagedResult, err := read(ctx, page, perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, pagedResult.Results...)
+
+		if page >= pagedResult.Pages {
+			return values, nil
+		}
+	}
+}
+
+// confirmDeleted looks up every stored item of t missing from values, keeping
+// the ones the upstream still has: the paginated read skips the items moving
+// across pages while it runs. The lookups stop once confirmations, shared by
+// every table of a sync, runs out, the items still missing being taken as
+// deleted: that many missing items were purged rather than skipped.
+func confirmDeleted[T any](ctx context.Context, store *Store, t table[T], values []T, lookup func(ctx context.Context, id int) (*T, error), confirmations *int) ([]T, error) {
+	missingIDs, err := missing(store, t, values)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range missingIDs {
+		if *confirmations <= 0 {
+			break
+		}
+		*confirmations--
+
+		value, err := lookup(ctx, id)
+		switch {
+		case errors.Is(err, clients.ErrNotFound):
+		case err != nil:
+			return nil, fmt.Errorf("looking up %s %d: %w", t.kind, id, err)
+		default:
+			values = append(values, *value)
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,178 @@
+package mirror
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+)
+
+func newTestStore(t *testing.T) *Store {
+	store, err := OpenStore(filepath.Join(t.TempDir(), "mirror.db"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	return store
+}
+
+func TestSyncer_Sync(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 2).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page: 1, Pages: 2, Results: []model.UserResponse{{ID: 3}, {ID: 2}},
+	}, nil)
+	userClient.EXPECT().GetUsers(mock.Anything, 2, 2).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page: 2, Pages: 2, Results: []model.UserResponse{{ID: 1}},
+	}, nil)
+	userClient.EXPECT().GetAllPosts(mock.Anything, 1, 2).Return(&paging.PagedResultResponse[model.PostResponse]{
+		Page: 1, Pages: 1, Results: []model.PostResponse{{ID: 10, UserID: 1}},
+	}, nil)
+	userClient.EXPECT().GetAllComments(mock.Anything, 1, 2).Return(&paging.PagedResultResponse[model.CommentResponse]{
+		Page: 1, Pages: 0,
+	}, nil)
+	userClient.EXPECT().GetAllTodos(mock.Anything, 1, 2).Return(&paging.PagedResultResponse[model.TodoResponse]{
+		Page: 1, Pages: 1, Results: []model.TodoResponse{{ID: 100, UserID: 1}},
+	}, nil)
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	store := newTestStore(t)
+	syncer := newSyncer(userClient, store, &Mirroring{Mode: ModeMirrored, PerPage: 2})
+	syncer.now = func() time.Time { return now }
+
+	snapshot, err := syncer.Sync(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Users, 3)
+
+	syncedAt, err := store.SyncedAt()
+	require.NoError(t, err)
+	assert.True(t, syncedAt.Equal(now))
+
+	client := NewMirroredUserClient(store)
+
+	pagedUsers, err := client.GetUsers(context.Background(), 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, pagedUsers.Total)
+
+	userTodos, err := client.GetTodos(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, userTodos, 1)
+}
+
+func TestSyncer_Sync_ConfirmDeleted(t *testing.T) {
+	store := newTestStore(t)
+	store.tracking = true
+
+	_, err := store.Save(&Snapshot{
+		SyncedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		Users:    []model.UserResponse{{ID: 1}, {ID: 2}},
+		Posts:    []model.PostResponse{{ID: 10, UserID: 1}},
+	})
+	require.NoError(t, err)
+
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page: 1, Pages: 1, Results: []model.UserResponse{{ID: 1}},
+	}, nil)
+	userClient.EXPECT().GetAllPosts(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.PostResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllComments(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllTodos(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.TodoResponse]{Page: 1}, nil)
+
+	// User 2 moved across pages while they were read, post 10 was deleted.
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(&model.UserResponse{ID: 2}, nil)
+	userClient.EXPECT().GetPost(mock.Anything, 10).Return(nil, clients.ErrNotFound)
+
+	snapshot, err := newSyncer(userClient, store, &Mirroring{Mode: ModeMirrored, PerPage: 100, ConfirmDeletions: 10}).Sync(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Users, 2)
+
+	changeLog, err := store.Changes(time.Time{}, "", 0)
+	require.NoError(t, err)
+	require.Len(t, changeLog.Changes, 1)
+	assert.Equal(t, clients.ResourcePosts, changeLog.Changes[0].Resource)
+	assert.Equal(t, ChangeDeleted, changeLog.Changes[0].Type)
+}
+
+func TestSyncer_Sync_ConfirmDeleted_Capped(t *testing.T) {
+	store := newTestStore(t)
+	store.tracking = true
+
+	_, err := store.Save(&Snapshot{
+		SyncedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		Users:    []model.UserResponse{{ID: 1}, {ID: 2}, {ID: 3}},
+		Posts:    []model.PostResponse{{ID: 10, UserID: 1}},
+	})
+	require.NoError(t, err)
+
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.UserResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllPosts(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.PostResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllComments(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllTodos(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.TodoResponse]{Page: 1}, nil)
+
+	// Only the first two missing items are looked up, the rest taken as deleted.
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil).Once()
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(nil, clients.ErrNotFound).Once()
+
+	snapshot, err := newSyncer(userClient, store, &Mirroring{Mode: ModeMirrored, PerPage: 100, ConfirmDeletions: 2}).Sync(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.UserResponse{{ID: 1}}, snapshot.Users)
+	assert.Empty(t, snapshot.Posts)
+
+	changeLog, err := store.Changes(time.Time{}, "", 0)
+	require.NoError(t, err)
+	assert.Len(t, changeLog.Changes, 3)
+}
+
+func TestSyncer_Sync_ConfirmDeleted_Err(t *testing.T) {
+	store := newTestStore(t)
+
+	_, err := store.Save(&Snapshot{Users: []model.UserResponse{{ID: 1}}})
+	require.NoError(t, err)
+
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.UserResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllPosts(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.PostResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllComments(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetAllTodos(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.TodoResponse]{Page: 1}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(nil, assert.AnError)
+
+	_, err = newSyncer(userClient, store, &Mirroring{Mode: ModeMirrored, PerPage: 100, ConfirmDeletions: 10}).Sync(context.Background())
+	require.ErrorIs(t, err, assert.AnError)
+
+	_, err = NewMirroredUserClient(store).GetUser(context.Background(), 1)
+	require.NoError(t, err)
+}
+
+func TestSyncer_Sync_Error(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page: 1, Pages: 1, Results: []model.UserResponse{{ID: 1}},
+	}, nil)
+	userClient.EXPECT().GetAllPosts(mock.Anything, 1, 100).Return(nil, assert.AnError)
+
+	store := newTestStore(t)
+
+	_, err := newSyncer(userClient, store, &Mirroring{Mode: ModeFallback, PerPage: 100}).Sync(context.Background())
+	require.ErrorIs(t, err, assert.AnError)
+
+	_, err = store.SyncedAt()
+	require.ErrorIs(t, err, ErrNotSynced)
+}
+
+func TestSyncer_Live(t *testing.T) {
+	syncer := newSyncer(mocks.NewMockIUserClient(t), nil, &Mirroring{Mode: ModeLive})
+
+	syncer.Start(context.Background())
+	syncer.Stop()
+}
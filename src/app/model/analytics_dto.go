@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+)
+
+type AnalyticsDTO struct {
+	GeneratedAt time.Time `json:"generated_at" xml:"generated_at"`
+	Refreshing  bool      `json:"refreshing" xml:"refreshing"`
+
+	Users int `json:"users" xml:"users"`
+
+	Gender []CountDTO `json:"gender" xml:"gender>count"`
+	Status []CountDTO `json:"status" xml:"status>count"`
+
+	PostsPerUser DistributionDTO `json:"posts_per_user" xml:"posts_per_user"`
+	TodosPerUser DistributionDTO `json:"todos_per_user" xml:"todos_per_user"`
+
+	TopCommenters []CountDTO `json:"top_commenters" xml:"top_commenters>count"`
+}
+
+type CountDTO struct {
+	Value string `json:"value" xml:"value"`
+	Count int    `json:"count" xml:"count"`
+}
+
+type DistributionDTO struct {
+	Mean float64 `json:"mean" xml:"mean"`
+	Min  int     `json:"min" xml:"min"`
+	P50  int     `json:"p50" xml:"p50"`
+	P90  int     `json:"p90" xml:"p90"`
+	P99  int     `json:"p99" xml:"p99"`
+	Max  int     `json:"max" xml:"max"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+type ChangesDTO struct {
+	Since      *time.Time `json:"since,omitempty" xml:"since,omitempty"`
+	SyncedAt   time.Time  `json:"synced_at" xml:"synced_at"`
+	NextCursor string     `json:"next_cursor" xml:"next_cursor"`
+	HasMore    bool       `json:"has_more" xml:"has_more"`
+
+	Changes []ChangeDTO `json:"changes" xml:"changes>change"`
+}
+
+type ChangeDTO struct {
+	Resource   string    `json:"resource" xml:"resource"`
+	ID         int       `json:"id" xml:"id"`
+	Type       string    `json:"type" xml:"type"`
+	DetectedAt time.Time `json:"detected_at" xml:"detected_at"`
+	Entity     any       `json:"entity" xml:"-"`
+}
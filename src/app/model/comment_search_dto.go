@@ -0,0 +1,24 @@
+package model
+
+type CommentSearchDTO struct {
+	Email  string `json:"email" xml:"email"`
+	Source string `json:"source" xml:"source"`
+	Total  int    `json:"total" xml:"total"`
+
+	Results []CommentSearchResultDTO `json:"results" xml:"results>comment"`
+}
+
+type CommentSearchResultDTO struct {
+	ID    int    `json:"id" xml:"id"`
+	Name  string `json:"name" xml:"name"`
+	Email string `json:"email" xml:"email"`
+	Body  string `json:"body" xml:"body"`
+
+	Post *CommentPostDTO `json:"post" xml:"post,omitempty"`
+}
+
+type CommentPostDTO struct {
+	ID     int        `json:"id" xml:"id"`
+	Title  string     `json:"title" xml:"title"`
+	Author *AuthorDTO `json:"author" xml:"author,omitempty"`
+}
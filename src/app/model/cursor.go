@@ -0,0 +1,47 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const cursorFormat = "page:%d:%d:%d"
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is where the feed resumes upstream: the post at Offset in the posts
+// Page, which follows the post ID when the page has not shifted since.
+type Cursor struct {
+	Page   int
+	Offset int
+	ID     int
+}
+
+func EncodeCursor(cursor Cursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(cursorFormat, cursor.Page, cursor.Offset, cursor.ID)))
+}
+
+// DecodeCursor decodes a cursor returned by EncodeCursor, an empty one being
+// the beginning of the feed.
+func DecodeCursor(cursor string) (Cursor, error) {
+	if cursor == "" {
+		return Cursor{Page: 1}, nil
+	}
+
+	value, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var decoded Cursor
+	if _, err = fmt.Sscanf(string(value), cursorFormat, &decoded.Page, &decoded.Offset, &decoded.ID); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	if decoded.Page < 1 || decoded.Offset < 0 || decoded.ID < 1 || EncodeCursor(decoded) != cursor {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return decoded, nil
+}
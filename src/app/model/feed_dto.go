@@ -0,0 +1,23 @@
+package model
+
+type FeedDTO struct {
+	Limit      int    `json:"limit" xml:"limit"`
+	NextCursor string `json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+
+	Results []FeedPostDTO `json:"results" xml:"results>post"`
+}
+
+type FeedPostDTO struct {
+	ID           int        `json:"id" xml:"id"`
+	Title        string     `json:"title" xml:"title"`
+	Body         string     `json:"body" xml:"body"`
+	CommentCount int        `json:"comment_count" xml:"comment_count"`
+	Author       *AuthorDTO `json:"author" xml:"author,omitempty"`
+}
+
+type AuthorDTO struct {
+	ID     int    `json:"id" xml:"id"`
+	Name   string `json:"name" xml:"name"`
+	Email  string `json:"email" xml:"email"`
+	Status string `json:"status" xml:"status"`
+}
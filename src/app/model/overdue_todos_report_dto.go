@@ -0,0 +1,44 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+type OverdueTodosReportDTO struct {
+	GeneratedAt time.Time `json:"generated_at" xml:"generated_at"`
+	Users       int       `json:"users" xml:"users"`
+	Todos       int       `json:"todos" xml:"todos"`
+
+	Results []OverdueUserDTO `json:"results" xml:"results>user"`
+}
+
+type OverdueUserDTO struct {
+	UserID int    `json:"user_id" xml:"user_id"`
+	Name   string `json:"name" xml:"name"`
+	Email  string `json:"email" xml:"email"`
+
+	Todos []OverdueTodoDTO `json:"todos" xml:"todos>todo"`
+}
+
+type OverdueTodoDTO struct {
+	ID          int       `json:"id" xml:"id"`
+	Title       string    `json:"title" xml:"title"`
+	DueOn       time.Time `json:"due_on" xml:"due_on"`
+	OverdueDays int       `json:"overdue_days" xml:"overdue_days"`
+}
+
+func (r OverdueTodosReportDTO) MarshalCSV() ([][]string, error) {
+	records := [][]string{{"user_id", "user_name", "user_email", "todo_id", "todo_title", "due_on", "overdue_days"}}
+
+	for _, user := range r.Results {
+		for _, todo := range user.Todos {
+			records = append(records, []string{
+				strconv.Itoa(user.UserID), user.Name, user.Email,
+				strconv.Itoa(todo.ID), todo.Title, todo.DueOn.Format(time.RFC3339), strconv.Itoa(todo.OverdueDays),
+			})
+		}
+	}
+
+	return records, nil
+}
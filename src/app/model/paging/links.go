@@ -0,0 +1,72 @@
+package paging
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	PageParam    = "page"
+	PerPageParam = "per_page"
+)
+
+type Links struct {
+	First string `json:"first,omitempty" xml:"first,omitempty"`
+	Prev  string `json:"prev,omitempty" xml:"prev,omitempty"`
+	Next  string `json:"next,omitempty" xml:"next,omitempty"`
+	Last  string `json:"last,omitempty" xml:"last,omitempty"`
+}
+
+// NewLinks builds the navigation links for the given page, keeping every other
+// query parameter of the request URL untouched.
+func NewLinks(requestURL *url.URL, page int, pages int) *Links {
+	last := max(pages, 1)
+
+	links := &Links{
+		First: pageURL(requestURL, 1),
+		Last:  pageURL(requestURL, last),
+	}
+
+	if page > 1 {
+		links.Prev = pageURL(requestURL, min(page-1, last))
+	}
+
+	if page < pages {
+		links.Next = pageURL(requestURL, page+1)
+	}
+
+	return links
+}
+
+// String formats the links as an RFC 8288 Link header value.
+func (r *Links) String() string {
+	var values []string
+
+	for _, link := range []struct {
+		rel string
+		url string
+	}{
+		{"first", r.First},
+		{"prev", r.Prev},
+		{"next", r.Next},
+		{"last", r.Last},
+	} {
+		if link.url != "" {
+			values = append(values, fmt.Sprintf("<%s>; rel=\"%s\"", link.url, link.rel))
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func pageURL(requestURL *url.URL, page int) string {
+	target := *requestURL
+
+	query := target.Query()
+	query.Set(PageParam, strconv.Itoa(page))
+	target.RawQuery = query.Encode()
+
+	return target.String()
+}
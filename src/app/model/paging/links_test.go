@@ -0,0 +1,65 @@
+package paging_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+)
+
+func TestNewLinks(t *testing.T) {
+	requestURL, err := url.Parse("https://localhost:8081/users?page=2&per_page=10&sort=name")
+	require.NoError(t, err)
+
+	links := paging.NewLinks(requestURL, 2, 5)
+
+	assert.Equal(t, "https://localhost:8081/users?page=1&per_page=10&sort=name", links.First)
+	assert.Equal(t, "https://localhost:8081/users?page=1&per_page=10&sort=name", links.Prev)
+	assert.Equal(t, "https://localhost:8081/users?page=3&per_page=10&sort=name", links.Next)
+	assert.Equal(t, "https://localhost:8081/users?page=5&per_page=10&sort=name", links.Last)
+}
+
+func TestNewLinks_FirstPage(t *testing.T) {
+	requestURL, err := url.Parse("/users")
+	require.NoError(t, err)
+
+	links := paging.NewLinks(requestURL, 1, 3)
+
+	assert.Empty(t, links.Prev)
+	assert.Equal(t, "/users?page=2", links.Next)
+	assert.Equal(t, "/users?page=3", links.Last)
+}
+
+func TestNewLinks_LastPage(t *testing.T) {
+	requestURL, err := url.Parse("/users?page=3")
+	require.NoError(t, err)
+
+	links := paging.NewLinks(requestURL, 3, 3)
+
+	assert.Equal(t, "/users?page=2", links.Prev)
+	assert.Empty(t, links.Next)
+}
+
+func TestNewLinks_NoPages(t *testing.T) {
+	requestURL, err := url.Parse("/users?page=4")
+	require.NoError(t, err)
+
+	links := paging.NewLinks(requestURL, 4, 0)
+
+	assert.Equal(t, "/users?page=1", links.First)
+	assert.Equal(t, "/users?page=1", links.Prev)
+	assert.Empty(t, links.Next)
+	assert.Equal(t, "/users?page=1", links.Last)
+}
+
+func TestLinks_String(t *testing.T) {
+	links := &paging.Links{
+		First: "/users?page=1",
+		Next:  "/users?page=2",
+		Last:  "/users?page=3",
+	}
+
+	assert.Equal(t, `</users?page=1>; rel="first", </users?page=2>; rel="next", </users?page=3>; rel="last"`, links.String())
+}
@@ -1,10 +1,41 @@
 package paging
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 type PagedResultDTO[T any] struct {
-	Limit int `json:"limit"`
-	Page  int `json:"page"`
-	Pages int `json:"pages"`
-	Total int `json:"total"`
+	XMLName xml.Name `json:"-" xml:"paged_result"`
+
+	Limit int `json:"limit" xml:"limit"`
+	Page  int `json:"page" xml:"page"`
+	Pages int `json:"pages" xml:"pages"`
+	Total int `json:"total" xml:"total"`
+
+	Results []T `json:"results" xml:"results>result"`
+
+	Links *Links `json:"links,omitempty" xml:"links,omitempty"`
+}
+
+// CSVRecorder is implemented by result types that can be flattened into CSV rows.
+type CSVRecorder interface {
+	CSVHeader() []string
+	CSVRecords() [][]string
+}
+
+func (r PagedResultDTO[T]) MarshalCSV() ([][]string, error) {
+	var zero T
+
+	recorder, ok := any(zero).(CSVRecorder)
+	if !ok {
+		return nil, fmt.Errorf("%T cannot be flattened into csv records", zero)
+	}
+
+	records := [][]string{recorder.CSVHeader()}
+	for i := 0; i < len(r.Results); i++ {
+		records = append(records, any(r.Results[i]).(CSVRecorder).CSVRecords()...)
+	}
 
-	Results []T `json:"results"`
+	return records, nil
 }
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+)
+
+type RateLimitDTO struct {
+	Known         bool      `json:"known" xml:"known"`
+	Limit         int       `json:"limit" xml:"limit"`
+	Remaining     int       `json:"remaining" xml:"remaining"`
+	Reserve       int       `json:"reserve" xml:"reserve"`
+	ResetAt       time.Time `json:"reset_at" xml:"reset_at"`
+	RatePerSecond float64   `json:"rate_per_second" xml:"rate_per_second"`
+	Tokens        float64   `json:"tokens" xml:"tokens"`
+	Throttled     bool      `json:"throttled" xml:"throttled"`
+	UpdatedAt     time.Time `json:"updated_at" xml:"updated_at"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+)
+
+type SearchDTO struct {
+	Query string `json:"query" xml:"query"`
+	Total int    `json:"total" xml:"total"`
+
+	Results []SearchHitDTO `json:"results" xml:"results>hit"`
+
+	Index SearchIndexDTO `json:"index" xml:"index"`
+}
+
+type SearchHitDTO struct {
+	Kind    string  `json:"kind" xml:"kind"`
+	ID      int     `json:"id" xml:"id"`
+	PostID  int     `json:"post_id" xml:"post_id"`
+	UserID  int     `json:"user_id" xml:"user_id"`
+	Title   string  `json:"title,omitempty" xml:"title,omitempty"`
+	Snippet string  `json:"snippet" xml:"snippet"`
+	Score   float64 `json:"score" xml:"score"`
+}
+
+type SearchIndexDTO struct {
+	Documents        int       `json:"documents" xml:"documents"`
+	Terms            int       `json:"terms" xml:"terms"`
+	RefreshedAt      time.Time `json:"refreshed_at" xml:"refreshed_at"`
+	StalenessSeconds float64   `json:"staleness_seconds" xml:"staleness_seconds"`
+	Refreshing       bool      `json:"refreshing" xml:"refreshing"`
+}
@@ -4,38 +4,52 @@ import (
 	"time"
 )
 
+const (
+	RelationPosts    = "posts"
+	RelationTodos    = "todos"
+	RelationComments = "comments"
+)
+
 type UserDTO struct {
-	ID     int    `json:"id"`
-	Name   string `json:"name"`
-	Email  string `json:"email"`
-	Gender string `json:"gender"`
-	Status string `json:"status"`
-
-	Posts []PostDTO `json:"posts"`
-	Todos []TodoDTO `json:"todos"`
+	ID     int    `json:"id" xml:"id"`
+	Name   string `json:"name" xml:"name"`
+	Email  string `json:"email" xml:"email"`
+	Gender string `json:"gender" xml:"gender"`
+	Status string `json:"status" xml:"status"`
+
+	Posts []PostDTO `json:"posts" xml:"posts>post"`
+	Todos []TodoDTO `json:"todos" xml:"todos>todo"`
+
+	Pending []string `json:"pending,omitempty" xml:"pending>relation,omitempty"`
 }
 
 type PostDTO struct {
-	ID     int    `json:"id"`
-	UserID int    `json:"-"`
-	Title  string `json:"title"`
-	Body   string `json:"body"`
+	ID     int    `json:"id" xml:"id"`
+	UserID int    `json:"-" xml:"-"`
+	Title  string `json:"title" xml:"title"`
+	Body   string `json:"body" xml:"body"`
 
-	Comments []CommentDTO `json:"comments"`
+	Comments []CommentDTO `json:"comments" xml:"comments>comment"`
 }
 
 type TodoDTO struct {
-	ID     int       `json:"id"`
-	UserID int       `json:"user_id"`
-	Title  string    `json:"title"`
-	DueOn  time.Time `json:"due_on"`
-	Status string    `json:"status"`
+	ID     int       `json:"id" xml:"id"`
+	UserID int       `json:"user_id" xml:"user_id"`
+	Title  string    `json:"title" xml:"title"`
+	DueOn  time.Time `json:"due_on" xml:"due_on"`
+	Status string    `json:"status" xml:"status"`
 }
 
 type CommentDTO struct {
-	ID     int    `json:"id"`
-	PostID int    `json:"post_id"`
-	Name   string `json:"name"`
-	Email  string `json:"email"`
-	Body   string `json:"body"`
+	ID     int    `json:"id" xml:"id"`
+	PostID int    `json:"post_id" xml:"post_id"`
+	Name   string `json:"name" xml:"name"`
+	Email  string `json:"email" xml:"email"`
+	Body   string `json:"body" xml:"body"`
+}
+
+// Partial tells whether some relations were still pending when the user was
+// returned, leaving them empty.
+func (r UserDTO) Partial() bool {
+	return len(r.Pending) > 0
 }
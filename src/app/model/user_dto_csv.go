@@ -0,0 +1,67 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+func (r UserDTO) CSVHeader() []string {
+	return []string{
+		"record", "user_id", "user_name", "user_email", "user_gender", "user_status",
+		"post_id", "post_title", "post_body",
+		"comment_id", "comment_name", "comment_email", "comment_body",
+		"todo_id", "todo_title", "todo_due_on", "todo_status",
+	}
+}
+
+// CSVRecords flattens the user into one row per leaf: a row per comment (or per
+// post without comments), a row per todo, and a single user row when the user
+// has neither posts nor todos.
+func (r UserDTO) CSVRecords() [][]string {
+	var records [][]string
+
+	for _, post := range r.Posts {
+		if len(post.Comments) == 0 {
+			records = append(records, r.csvRecord("post", &post, nil, nil))
+			continue
+		}
+		for _, comment := range post.Comments {
+			records = append(records, r.csvRecord("comment", &post, &comment, nil))
+		}
+	}
+
+	for _, todo := range r.Todos {
+		records = append(records, r.csvRecord("todo", nil, nil, &todo))
+	}
+
+	if len(records) == 0 {
+		records = append(records, r.csvRecord("user", nil, nil, nil))
+	}
+
+	return records
+}
+
+func (r UserDTO) csvRecord(record string, post *PostDTO, comment *CommentDTO, todo *TodoDTO) []string {
+	values := make([]string, 0, 17)
+	values = append(values, record, strconv.Itoa(r.ID), r.Name, r.Email, r.Gender, r.Status)
+
+	if post != nil {
+		values = append(values, strconv.Itoa(post.ID), post.Title, post.Body)
+	} else {
+		values = append(values, "", "", "")
+	}
+
+	if comment != nil {
+		values = append(values, strconv.Itoa(comment.ID), comment.Name, comment.Email, comment.Body)
+	} else {
+		values = append(values, "", "", "", "")
+	}
+
+	if todo != nil {
+		values = append(values, strconv.Itoa(todo.ID), todo.Title, todo.DueOn.Format(time.RFC3339), todo.Status)
+	} else {
+		values = append(values, "", "", "", "")
+	}
+
+	return values
+}
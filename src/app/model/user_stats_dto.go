@@ -0,0 +1,98 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	TodoStatusPending   = "pending"
+	TodoStatusCompleted = "completed"
+)
+
+type UserStatsDTO struct {
+	ID     int    `json:"id" xml:"id"`
+	Name   string `json:"name" xml:"name"`
+	Email  string `json:"email" xml:"email"`
+	Gender string `json:"gender" xml:"gender"`
+	Status string `json:"status" xml:"status"`
+
+	Stats StatsDTO `json:"stats" xml:"stats"`
+
+	Pending []string `json:"pending,omitempty" xml:"pending>relation,omitempty"`
+}
+
+type StatsDTO struct {
+	Posts            int          `json:"posts" xml:"posts"`
+	CommentsReceived int          `json:"comments_received" xml:"comments_received"`
+	Todos            TodoStatsDTO `json:"todos" xml:"todos"`
+}
+
+type TodoStatsDTO struct {
+	Total     int `json:"total" xml:"total"`
+	Pending   int `json:"pending" xml:"pending"`
+	Completed int `json:"completed" xml:"completed"`
+	Overdue   int `json:"overdue" xml:"overdue"`
+}
+
+func NewUserStatsDTO(user *UserDTO, now time.Time) UserStatsDTO {
+	stats := UserStatsDTO{
+		ID:      user.ID,
+		Name:    user.Name,
+		Email:   user.Email,
+		Gender:  user.Gender,
+		Status:  user.Status,
+		Pending: user.Pending,
+		Stats: StatsDTO{
+			Posts: len(user.Posts),
+			Todos: TodoStatsDTO{
+				Total: len(user.Todos),
+			},
+		},
+	}
+
+	for i := 0; i < len(user.Posts); i++ {
+		stats.Stats.CommentsReceived += len(user.Posts[i].Comments)
+	}
+
+	for i := 0; i < len(user.Todos); i++ {
+		switch user.Todos[i].Status {
+		case TodoStatusPending:
+			stats.Stats.Todos.Pending++
+			if user.Todos[i].IsOverdue(now) {
+				stats.Stats.Todos.Overdue++
+			}
+		case TodoStatusCompleted:
+			stats.Stats.Todos.Completed++
+		}
+	}
+
+	return stats
+}
+
+func (r TodoDTO) IsOverdue(now time.Time) bool {
+	return r.Status == TodoStatusPending && !r.DueOn.IsZero() && r.DueOn.Before(now)
+}
+
+func (r UserStatsDTO) Partial() bool {
+	return len(r.Pending) > 0
+}
+
+func (r UserStatsDTO) CSVHeader() []string {
+	return []string{
+		"user_id", "user_name", "user_email", "user_gender", "user_status",
+		"posts", "comments_received", "todos", "todos_pending", "todos_completed", "todos_overdue",
+	}
+}
+
+func (r UserStatsDTO) CSVRecords() [][]string {
+	return [][]string{{
+		strconv.Itoa(r.ID), r.Name, r.Email, r.Gender, r.Status,
+		strconv.Itoa(r.Stats.Posts),
+		strconv.Itoa(r.Stats.CommentsReceived),
+		strconv.Itoa(r.Stats.Todos.Total),
+		strconv.Itoa(r.Stats.Todos.Pending),
+		strconv.Itoa(r.Stats.Todos.Completed),
+		strconv.Itoa(r.Stats.Todos.Overdue),
+	}}
+}
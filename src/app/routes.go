@@ -14,4 +14,12 @@ type Routes struct {
 
 func (r *Routes) Register() {
 	r.AddRoute(http.MethodGet, "/users", container.Provide[controllers.IUsersController]().GetUsers)
+	r.AddRoute(http.MethodGet, "/users/:id/stats", container.Provide[controllers.IUsersController]().GetUserStats)
+	r.AddRoute(http.MethodGet, "/analytics", container.Provide[controllers.IAnalyticsController]().GetAnalytics)
+	r.AddRoute(http.MethodGet, "/feed", container.Provide[controllers.IFeedController]().GetFeed)
+	r.AddRoute(http.MethodGet, "/comments", container.Provide[controllers.ICommentsController]().GetComments)
+	r.AddRoute(http.MethodGet, "/search", container.Provide[controllers.ISearchController]().Search)
+	r.AddRoute(http.MethodGet, "/reports/overdue-todos", container.Provide[controllers.IReportsController]().GetOverdueTodos)
+	r.AddRoute(http.MethodGet, "/diagnostics/rate-limit", container.Provide[controllers.IDiagnosticsController]().GetRateLimit)
+	r.AddRoute(http.MethodGet, "/changes", container.Provide[controllers.IChangesController]().GetChanges)
 }
@@ -0,0 +1,272 @@
+package search
+
+import (
+	"cmp"
+	"html"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	titleBoost   = 2.0
+	prefixWeight = 0.5
+	snippetSize  = 160
+)
+
+type Kind string
+
+const (
+	KindPost    Kind = "post"
+	KindComment Kind = "comment"
+)
+
+type Document struct {
+	ID       string
+	Kind     Kind
+	EntityID int
+	PostID   int
+	UserID   int
+	Title    string
+	Body     string
+}
+
+type Hit struct {
+	Document Document
+	Score    float64
+	Snippet  string
+}
+
+type posting struct {
+	title int
+	body  int
+}
+
+type Index struct {
+	mtx      sync.RWMutex
+	docs     map[string]Document
+	postings map[string]map[string]posting
+	terms    []string
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]Document),
+		postings: make(map[string]map[string]posting),
+	}
+}
+
+// Replace swaps the indexed documents for the given ones. The new index is
+// built aside so searches keep running against the previous one meanwhile.
+func (r *Index) Replace(docs []Document) {
+	next := NewIndex()
+	for _, doc := range docs {
+		next.add(doc)
+	}
+	next.sortTerms()
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.docs, r.postings, r.terms = next.docs, next.postings, next.terms
+}
+
+func (r *Index) Documents() int {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return len(r.docs)
+}
+
+func (r *Index) Terms() int {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return len(r.terms)
+}
+
+// Search returns the documents matching every query term, either exactly or
+// by prefix, ranked by tf-idf with title matches boosted.
+func (r *Index) Search(query string, limit int) ([]Hit, int) {
+	queryTerms := terms(query)
+	slices.Sort(queryTerms)
+	queryTerms = slices.Compact(queryTerms)
+	if len(queryTerms) == 0 {
+		return nil, 0
+	}
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var (
+		scores  map[string]float64
+		matched = make(map[string]bool)
+	)
+
+	for _, queryTerm := range queryTerms {
+		termScores := make(map[string]float64)
+
+		for _, term := range r.expand(queryTerm) {
+			weight := 1.0
+			if term != queryTerm {
+				weight = prefixWeight
+			}
+			matched[term] = true
+
+			idf := math.Log(1 + float64(len(r.docs))/float64(len(r.postings[term])))
+			for docID, posting := range r.postings[term] {
+				tf := titleBoost*float64(posting.title) + float64(posting.body)
+				termScores[docID] += weight * idf * (1 + math.Log(tf))
+			}
+		}
+
+		scores = intersect(scores, termScores)
+		if len(scores) == 0 {
+			return nil, 0
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for docID, score := range scores {
+		hits = append(hits, Hit{Document: r.docs[docID], Score: score})
+	}
+
+	slices.SortFunc(hits, func(a, b Hit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Document.ID, b.Document.ID)
+	})
+
+	total := len(hits)
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	for i := 0; i < len(hits); i++ {
+		hits[i].Snippet = snippet(&hits[i].Document, matched)
+	}
+
+	return hits, total
+}
+
+func (r *Index) add(doc Document) {
+	r.docs[doc.ID] = doc
+
+	for _, term := range terms(doc.Title) {
+		r.posting(term, doc.ID, func(p *posting) { p.title++ })
+	}
+
+	for _, term := range terms(doc.Body) {
+		r.posting(term, doc.ID, func(p *posting) { p.body++ })
+	}
+}
+
+func (r *Index) posting(term string, docID string, f func(p *posting)) {
+	docs, found := r.postings[term]
+	if !found {
+		docs = make(map[string]posting)
+		r.postings[term] = docs
+	}
+
+	p := docs[docID]
+	f(&p)
+	docs[docID] = p
+}
+
+func (r *Index) sortTerms() {
+	r.terms = make([]string, 0, len(r.postings))
+	for term := range r.postings {
+		r.terms = append(r.terms, term)
+	}
+
+	sort.Strings(r.terms)
+}
+
+// expand returns the indexed terms starting with the given prefix, the exact
+// term included.
+func (r *Index) expand(prefix string) []string {
+	var result []string
+
+	for i := sort.SearchStrings(r.terms, prefix); i < len(r.terms) && strings.HasPrefix(r.terms[i], prefix); i++ {
+		result = append(result, r.terms[i])
+	}
+
+	return result
+}
+
+func intersect(scores map[string]float64, termScores map[string]float64) map[string]float64 {
+	if scores == nil {
+		return termScores
+	}
+
+	for docID, score := range scores {
+		termScore, found := termScores[docID]
+		if !found {
+			delete(scores, docID)
+			continue
+		}
+		scores[docID] = score + termScore
+	}
+
+	return scores
+}
+
+// snippet returns a window of the body around the first matched term, HTML
+// escaped, matches wrapped in <em> tags. The title is used when the body has no
+// match.
+func snippet(doc *Document, matched map[string]bool) string {
+	text := doc.Body
+	tokens := tokenize(text)
+
+	first := slices.IndexFunc(tokens, func(t token) bool { return matched[t.term] })
+	if first < 0 {
+		text = doc.Title
+		tokens = tokenize(text)
+		first = max(slices.IndexFunc(tokens, func(t token) bool { return matched[t.term] }), 0)
+	}
+
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	from := max(tokens[first].start-snippetSize/4, 0)
+	for from > 0 && !isBoundary(text, from) {
+		from--
+	}
+
+	to := min(from+snippetSize, len(text))
+	for to < len(text) && !isBoundary(text, to) {
+		to++
+	}
+
+	var builder strings.Builder
+	if from > 0 {
+		builder.WriteString("…")
+	}
+
+	cursor := from
+	for _, t := range tokens {
+		if t.start < from || t.end > to || !matched[t.term] {
+			continue
+		}
+		builder.WriteString(html.EscapeString(text[cursor:t.start]))
+		builder.WriteString("<em>")
+		builder.WriteString(html.EscapeString(text[t.start:t.end]))
+		builder.WriteString("</em>")
+		cursor = t.end
+	}
+	builder.WriteString(html.EscapeString(text[cursor:to]))
+
+	if to < len(text) {
+		builder.WriteString("…")
+	}
+
+	return builder.String()
+}
+
+func isBoundary(text string, i int) bool {
+	return text[i] == ' '
+}
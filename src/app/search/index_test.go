@@ -0,0 +1,118 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/search"
+)
+
+func newIndex() *search.Index {
+	index := search.NewIndex()
+	index.Replace([]search.Document{
+		{ID: "post:1", Kind: search.KindPost, PostID: 1, Title: "Golang concurrency patterns", Body: "Worker pools and pipelines in Go."},
+		{ID: "post:2", Kind: search.KindPost, PostID: 2, Title: "Cooking pasta", Body: "Boil water, add salt and cook the pasta for ten minutes."},
+		{ID: "comment:1", Kind: search.KindComment, PostID: 1, Body: "Great overview of concurrency, pipelines are my favourite pattern."},
+	})
+
+	return index
+}
+
+func TestIndex_Search(t *testing.T) {
+	hits, total := newIndex().Search("concurrency", 10)
+
+	assert.Equal(t, 2, total)
+	require.Len(t, hits, 2)
+	assert.Equal(t, "post:1", hits[0].Document.ID, "title matches are boosted")
+	assert.Equal(t, "comment:1", hits[1].Document.ID)
+	assert.Equal(t, "Great overview of <em>concurrency</em>, pipelines are my favourite pattern.", hits[1].Snippet)
+}
+
+func TestIndex_Search_MultiTerm(t *testing.T) {
+	hits, total := newIndex().Search("Pipelines FAVOURITE", 10)
+
+	assert.Equal(t, 1, total)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "comment:1", hits[0].Document.ID)
+	assert.Equal(t, "Great overview of concurrency, <em>pipelines</em> are my <em>favourite</em> pattern.", hits[0].Snippet)
+}
+
+func TestIndex_Search_RepeatedTerm(t *testing.T) {
+	index := newIndex()
+
+	hits, _ := index.Search("pipelines favourite", 10)
+	require.Len(t, hits, 1)
+
+	repeated, _ := index.Search("pipelines favourite pipelines", 10)
+	require.Len(t, repeated, 1)
+	assert.Equal(t, hits[0].Score, repeated[0].Score)
+}
+
+func TestIndex_Search_Prefix(t *testing.T) {
+	hits, total := newIndex().Search("pat", 10)
+
+	assert.Equal(t, 2, total)
+	require.Len(t, hits, 2)
+	assert.Equal(t, "post:1", hits[0].Document.ID)
+	assert.Equal(t, "Golang concurrency <em>patterns</em>", hits[0].Snippet)
+}
+
+func TestIndex_Search_Escaped(t *testing.T) {
+	index := search.NewIndex()
+	index.Replace([]search.Document{
+		{ID: "comment:1", Kind: search.KindComment, Body: `Nice post <script>alert("pwned")</script> & thanks`},
+	})
+
+	hits, total := index.Search("script", 10)
+
+	assert.Equal(t, 1, total)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "Nice post &lt;<em>script</em>&gt;alert(&#34;pwned&#34;)&lt;/<em>script</em>&gt; &amp; thanks", hits[0].Snippet)
+}
+
+func TestIndex_Search_NoMatch(t *testing.T) {
+	hits, total := newIndex().Search("pasta concurrency", 10)
+
+	assert.Zero(t, total)
+	assert.Empty(t, hits)
+
+	hits, total = newIndex().Search("  ", 10)
+
+	assert.Zero(t, total)
+	assert.Empty(t, hits)
+}
+
+func TestIndex_Search_Limit(t *testing.T) {
+	hits, total := newIndex().Search("p", 1)
+
+	assert.Equal(t, 3, total)
+	assert.Len(t, hits, 1)
+}
+
+func TestIndex_Replace(t *testing.T) {
+	index := newIndex()
+	assert.Equal(t, 3, index.Documents())
+	assert.Positive(t, index.Terms())
+
+	index.Replace([]search.Document{{ID: "post:3", Title: "Hello"}})
+
+	assert.Equal(t, 1, index.Documents())
+	hits, _ := index.Search("pasta", 10)
+	assert.Empty(t, hits)
+}
+
+func TestIndex_Search_LongBody(t *testing.T) {
+	index := search.NewIndex()
+	body := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore " +
+		"magna aliqua. Ut enim ad minim veniam, quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodo " +
+		"consequat. Duis aute irure dolor in reprehenderit in voluptate velit esse cillum dolore eu fugiat nulla pariatur."
+	index.Replace([]search.Document{{ID: "post:1", Body: body}})
+
+	hits, _ := index.Search("reprehenderit", 10)
+
+	require.Len(t, hits, 1)
+	assert.Contains(t, hits[0].Snippet, "<em>reprehenderit</em>")
+	assert.True(t, hits[0].Snippet[:3] == "…")
+	assert.Less(t, len(hits[0].Snippet), len(body))
+}
@@ -0,0 +1,48 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+// tokenize splits text into lower-cased terms, keeping the byte offsets of each
+// term in the original text so matches can be highlighted.
+func tokenize(text string) []token {
+	var tokens []token
+
+	start := -1
+	for i, char := range text {
+		isWord := unicode.IsLetter(char) || unicode.IsDigit(char)
+
+		switch {
+		case isWord && start < 0:
+			start = i
+		case !isWord && start >= 0:
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+
+	return tokens
+}
+
+func terms(text string) []string {
+	tokens := tokenize(text)
+
+	result := make([]string, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		result[i] = tokens[i].term
+	}
+
+	return result
+}
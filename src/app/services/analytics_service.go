@@ -0,0 +1,211 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	log "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-logger"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+var ErrAnalyticsPending = errors.New("analytics are being computed")
+
+type IAnalyticsService interface {
+	GetAnalytics() (*model.AnalyticsDTO, error)
+	Refresh(ctx context.Context) error
+}
+
+// AnalyticsService scans every user in the background and serves the last
+// computed snapshot, triggering a new scan once it is older than the ttl and
+// backing off after failed scans.
+type AnalyticsService struct {
+	usersService  IUsersService
+	ttl           time.Duration
+	topCommenters int
+
+	mtx        sync.Mutex
+	snapshot   *model.AnalyticsDTO
+	refreshing bool
+	lastErr    error
+	retry      backoff
+}
+
+func NewAnalyticsService(usersService IUsersService) *AnalyticsService {
+	ttl := time.Duration(config.TryInt("analytics.ttl", 300000)) * time.Millisecond
+
+	return &AnalyticsService{
+		usersService:  usersService,
+		ttl:           ttl,
+		topCommenters: config.TryInt("analytics.top-commenters", 10),
+		retry:         newBackoff(time.Duration(config.TryInt("analytics.retry-delay", 5000))*time.Millisecond, ttl),
+	}
+}
+
+func (r *AnalyticsService) GetAnalytics() (*model.AnalyticsDTO, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	now := time.Now()
+	if (r.snapshot == nil || now.Sub(r.snapshot.GeneratedAt) > r.ttl) && r.retry.ready(now) {
+		r.refreshAsync()
+	}
+
+	if r.snapshot == nil {
+		if r.lastErr != nil {
+			return nil, errors.Join(ErrAnalyticsPending, r.lastErr)
+		}
+		return nil, ErrAnalyticsPending
+	}
+
+	snapshot := *r.snapshot
+	snapshot.Refreshing = r.refreshing
+
+	return &snapshot, nil
+}
+
+func (r *AnalyticsService) Refresh(ctx context.Context) error {
+	accumulator := newAnalyticsAccumulator()
+
+	if err := r.usersService.ScanUsers(ctx, 100, func(users []model.UserDTO) error {
+		accumulator.add(users)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	snapshot := accumulator.result(time.Now(), r.topCommenters)
+
+	r.mtx.Lock()
+	r.snapshot = snapshot
+	r.mtx.Unlock()
+
+	return nil
+}
+
+func (r *AnalyticsService) refreshAsync() {
+	if r.refreshing {
+		return
+	}
+	r.refreshing = true
+
+	go func() {
+		err := r.Refresh(clients.WithCaller(context.Background()))
+		if err != nil {
+			log.Errorf("analytics refresh failed: %v", err)
+		}
+
+		r.mtx.Lock()
+		r.refreshing = false
+		r.lastErr = err
+		if err != nil {
+			r.retry.failed(time.Now())
+		} else {
+			r.retry.succeeded()
+		}
+		r.mtx.Unlock()
+	}()
+}
+
+type analyticsAccumulator struct {
+	users      int
+	gender     map[string]int
+	status     map[string]int
+	posts      []int
+	todos      []int
+	commenters map[string]int
+}
+
+func newAnalyticsAccumulator() *analyticsAccumulator {
+	return &analyticsAccumulator{
+		gender:     make(map[string]int),
+		status:     make(map[string]int),
+		commenters: make(map[string]int),
+	}
+}
+
+func (r *analyticsAccumulator) add(users []model.UserDTO) {
+	for i := 0; i < len(users); i++ {
+		user := &users[i]
+
+		r.users++
+		r.gender[user.Gender]++
+		r.status[user.Status]++
+		r.posts = append(r.posts, len(user.Posts))
+		r.todos = append(r.todos, len(user.Todos))
+
+		for k := 0; k < len(user.Posts); k++ {
+			for _, comment := range user.Posts[k].Comments {
+				r.commenters[comment.Email]++
+			}
+		}
+	}
+}
+
+func (r *analyticsAccumulator) result(now time.Time, topCommenters int) *model.AnalyticsDTO {
+	commenters := counts(r.commenters)
+	if len(commenters) > topCommenters {
+		commenters = commenters[:topCommenters]
+	}
+
+	return &model.AnalyticsDTO{
+		GeneratedAt:   now,
+		Users:         r.users,
+		Gender:        counts(r.gender),
+		Status:        counts(r.status),
+		PostsPerUser:  distribution(r.posts),
+		TodosPerUser:  distribution(r.todos),
+		TopCommenters: commenters,
+	}
+}
+
+func counts(values map[string]int) []model.CountDTO {
+	result := make([]model.CountDTO, 0, len(values))
+	for value, count := range values {
+		result = append(result, model.CountDTO{Value: value, Count: count})
+	}
+
+	slices.SortFunc(result, func(a, b model.CountDTO) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+
+	return result
+}
+
+func distribution(values []int) model.DistributionDTO {
+	if len(values) == 0 {
+		return model.DistributionDTO{}
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var sum int
+	for _, value := range sorted {
+		sum += value
+	}
+
+	return model.DistributionDTO{
+		Mean: float64(sum) / float64(len(sorted)),
+		Min:  sorted[0],
+		P50:  percentile(sorted, 50),
+		P90:  percentile(sorted, 90),
+		P99:  percentile(sorted, 99),
+		Max:  sorted[len(sorted)-1],
+	}
+}
+
+// percentile uses the nearest-rank method over an already sorted slice.
+func percentile(sorted []int, p float64) int {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
@@ -0,0 +1,96 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/services"
+)
+
+func usersWithPosts(from int, to int) []model.UserDTO {
+	var users []model.UserDTO
+	for id := from; id <= to; id++ {
+		user := model.UserDTO{ID: id, Gender: "male", Status: "active"}
+		if id%2 == 0 {
+			user.Gender = "female"
+		}
+		if id%4 == 0 {
+			user.Status = "inactive"
+		}
+		for k := 0; k < id; k++ {
+			user.Posts = append(user.Posts, model.PostDTO{
+				ID:       id*100 + k,
+				Comments: []model.CommentDTO{{Email: fmt.Sprintf("commenter%d@example.com", k)}},
+			})
+		}
+		user.Todos = make([]model.TodoDTO, id%3)
+		users = append(users, user)
+	}
+
+	return users
+}
+
+func TestAnalyticsService_GetAnalytics(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	usersService.EXPECT().ScanUsers(mock.Anything, 100, mock.Anything).RunAndReturn(func(_ context.Context, _ int, f func([]model.UserDTO) error) error {
+		if err := f(usersWithPosts(1, 5)); err != nil {
+			return err
+		}
+		return f(usersWithPosts(6, 10))
+	})
+
+	analyticsService := services.NewAnalyticsService(usersService)
+	require.NoError(t, analyticsService.Refresh(context.Background()))
+
+	actual, err := analyticsService.GetAnalytics()
+	require.NoError(t, err)
+
+	assert.False(t, actual.GeneratedAt.IsZero())
+	assert.Equal(t, 10, actual.Users)
+	assert.Equal(t, []model.CountDTO{{Value: "female", Count: 5}, {Value: "male", Count: 5}}, actual.Gender)
+	assert.Equal(t, []model.CountDTO{{Value: "active", Count: 8}, {Value: "inactive", Count: 2}}, actual.Status)
+	assert.Equal(t, model.DistributionDTO{Mean: 5.5, Min: 1, P50: 5, P90: 9, P99: 10, Max: 10}, actual.PostsPerUser)
+	assert.Equal(t, 0, actual.TodosPerUser.Min)
+	assert.Equal(t, 2, actual.TodosPerUser.Max)
+	require.Len(t, actual.TopCommenters, 10)
+	assert.Equal(t, model.CountDTO{Value: "commenter0@example.com", Count: 10}, actual.TopCommenters[0])
+	assert.Equal(t, model.CountDTO{Value: "commenter9@example.com", Count: 1}, actual.TopCommenters[9])
+}
+
+func TestAnalyticsService_GetAnalytics_Pending(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	var scans atomic.Int32
+	usersService.EXPECT().ScanUsers(mock.Anything, 100, mock.Anything).RunAndReturn(func(context.Context, int, func([]model.UserDTO) error) error {
+		scans.Add(1)
+		return errors.New("some error")
+	})
+
+	analyticsService := services.NewAnalyticsService(usersService)
+	actual, err := analyticsService.GetAnalytics()
+
+	require.ErrorIs(t, err, services.ErrAnalyticsPending)
+	assert.Nil(t, actual)
+
+	// Backing off, the failed scan is not retried by the requests that follow.
+	assert.Eventually(t, func() bool {
+		_, err := analyticsService.GetAnalytics()
+		return err != nil && strings.Contains(err.Error(), "some error")
+	}, time.Second, time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		_, err = analyticsService.GetAnalytics()
+		require.ErrorIs(t, err, services.ErrAnalyticsPending)
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, int32(1), scans.Load())
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+)
+
+// getAuthors fetches the author summary of every distinct user id. Users that
+// no longer exist upstream are left out, since posts may outlive their author
+// on gorest.
+func getAuthors(ctx context.Context, userClient clients.IUserClient, userIDs []int, concurrency int) (map[int]*model.AuthorDTO, error) {
+	userIDs = distinct(userIDs)
+
+	results, err := tpl.MapErr(ctx, userIDs, func(ctx context.Context, userID *int) (*model.AuthorDTO, error) {
+		userResponse, err := userClient.GetUser(ctx, *userID)
+		switch {
+		case errors.Is(err, clients.ErrNotFound):
+			return nil, nil
+		case err != nil:
+			return nil, err
+		}
+
+		return &model.AuthorDTO{
+			ID:     userResponse.ID,
+			Name:   userResponse.Name,
+			Email:  userResponse.Email,
+			Status: userResponse.Status,
+		}, nil
+	}, concurrency)
+
+	authors := make(map[int]*model.AuthorDTO, len(userIDs))
+	for i, author := range results {
+		if author != nil {
+			authors[userIDs[i]] = author
+		}
+	}
+
+	return authors, err
+}
+
+func distinct(ids []int) []int {
+	result := slices.Clone(ids)
+	slices.Sort(result)
+
+	return slices.Compact(result)
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"time"
+)
+
+// backoff spaces out the retries of a failing background refresh, doubling
+// the delay after every consecutive failure up to max.
+type backoff struct {
+	base time.Duration
+	max  time.Duration
+
+	failures int
+	retryAt  time.Time
+}
+
+func newBackoff(base time.Duration, max time.Duration) backoff {
+	return backoff{
+		base: base,
+		max:  max,
+	}
+}
+
+// ready tells whether a refresh may start at now.
+func (r *backoff) ready(now time.Time) bool {
+	return !now.Before(r.retryAt)
+}
+
+func (r *backoff) failed(now time.Time) {
+	r.failures++
+
+	delay := r.max
+	if shift := r.failures - 1; shift < 32 && r.base<<shift < r.max {
+		delay = r.base << shift
+	}
+
+	r.retryAt = now.Add(delay)
+}
+
+func (r *backoff) succeeded() {
+	r.failures = 0
+	r.retryAt = time.Time{}
+}
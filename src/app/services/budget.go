@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+type budgetKey struct{}
+
+// WithBudget overrides, for the calls made with ctx, the default time the
+// services wait for relations before answering with what they have, a zero
+// budget waiting for all of them.
+func WithBudget(ctx context.Context, budget time.Duration) context.Context {
+	return context.WithValue(ctx, budgetKey{}, budget)
+}
+
+func budgetFrom(ctx context.Context) (time.Duration, bool) {
+	budget, found := ctx.Value(budgetKey{}).(time.Duration)
+	return budget, found
+}
+
+// withDeadline is context.WithDeadline, a zero deadline leaving ctx unbounded.
+func withDeadline(ctx context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	if deadline.IsZero() {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithDeadline(ctx, deadline)
+}
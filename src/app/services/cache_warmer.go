@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	log "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-logger"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+var (
+	cacheWarmerRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gorest_api",
+		Subsystem: "cache_warmer",
+		Name:      "runs_total",
+		Help:      "Cache warming runs, by outcome.",
+	}, []string{"outcome"})
+	cacheWarmerWarmed = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "cache_warmer",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix time of the last run that warmed every configured page.",
+	})
+)
+
+// Warming is which pages of users are kept warm and how often, no pages
+// turning the warmer off. Stagger is the least time between two upstream calls
+// of a run, RefreshAhead how close to expiry a cached entry is refetched, and
+// ReservePercent the share of the upstream rate limit left to user traffic.
+type Warming struct {
+	Pages          int
+	PerPage        int
+	Interval       time.Duration
+	Stagger        time.Duration
+	RefreshAhead   time.Duration
+	ReservePercent int
+}
+
+func NewWarming() *Warming {
+	return &Warming{
+		Pages:          config.TryInt("warmer.pages", 0),
+		PerPage:        config.TryInt("warmer.per-page", 10),
+		Interval:       time.Duration(max(config.TryInt("warmer.interval-minutes", 5), 1)) * time.Minute,
+		Stagger:        time.Duration(config.TryInt("warmer.stagger", 200)) * time.Millisecond,
+		RefreshAhead:   time.Duration(config.TryInt("warmer.refresh-ahead", 2000)) * time.Millisecond,
+		ReservePercent: config.TryInt("warmer.reserve-percent", 50),
+	}
+}
+
+type ICacheWarmer interface {
+	Start(ctx context.Context)
+	Stop()
+}
+
+// CacheWarmer aggregates the first pages of users every interval, refetching
+// only the upstream cache entries about to expire, one call per stagger, and
+// giving up on a run once the upstream rate budget falls to the reserve.
+type CacheWarmer struct {
+	usersService IUsersService
+	limiter      clients.IRateLimiter
+	warming      *Warming
+
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewCacheWarmer(usersService IUsersService, limiter clients.IRateLimiter, warming *Warming) *CacheWarmer {
+	return &CacheWarmer{
+		usersService: usersService,
+		limiter:      limiter,
+		warming:      warming,
+	}
+}
+
+func (r *CacheWarmer) Start(ctx context.Context) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.warming.Pages < 1 || r.cancel != nil {
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.warming.Interval)
+		defer ticker.Stop()
+
+		for {
+			r.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop cancels the run in progress and waits for the warmer to return.
+func (r *CacheWarmer) Stop() {
+	r.mtx.Lock()
+	cancel, done := r.cancel, r.done
+	r.mtx.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+func (r *CacheWarmer) run(ctx context.Context) {
+	outcome, err := r.warm(ctx)
+	if err != nil && ctx.Err() == nil {
+		log.Warnf("cache warming failed: %v", err)
+	}
+
+	cacheWarmerRuns.WithLabelValues(outcome).Inc()
+	if outcome == "ok" {
+		cacheWarmerWarmed.SetToCurrentTime()
+	}
+}
+
+func (r *CacheWarmer) warm(ctx context.Context) (string, error) {
+	ctx = clients.WithRefreshAhead(clients.WithCaller(ctx), r.warming.RefreshAhead)
+	ctx = WithBudget(clients.WithPacing(ctx, r.warming.Stagger), 0)
+
+	for page := 1; page <= r.warming.Pages; page++ {
+		if err := ctx.Err(); err != nil {
+			return "canceled", err
+		}
+
+		if !r.spare() {
+			return "throttled", nil
+		}
+
+		pagedResult, err := r.usersService.GetUsers(ctx, page, r.warming.PerPage)
+		if err != nil {
+			if ctx.Err() != nil {
+				return "canceled", err
+			}
+			return "error", err
+		}
+
+		if page >= pagedResult.Pages {
+			break
+		}
+	}
+
+	return "ok", nil
+}
+
+// spare tells whether the upstream rate budget is above the reserve, an
+// unknown budget counting as spare until the upstream says otherwise.
+func (r *CacheWarmer) spare() bool {
+	snapshot := r.limiter.Snapshot()
+	if snapshot.Throttled {
+		return false
+	}
+
+	return !snapshot.Known || snapshot.Remaining*100 > snapshot.Limit*r.warming.ReservePercent
+}
@@ -0,0 +1,138 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	clientmocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/services"
+)
+
+func TestCacheWarmer(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	limiter := clientmocks.NewMockIRateLimiter(t)
+
+	warmed := make(chan int, 10)
+
+	limiter.EXPECT().Snapshot().Return(model.RateLimitDTO{})
+	usersService.EXPECT().GetUsers(mock.Anything, mock.Anything, 20).RunAndReturn(func(_ context.Context, page int, _ int) (*paging.PagedResultDTO[model.UserDTO], error) {
+		warmed <- page
+		return &paging.PagedResultDTO[model.UserDTO]{Page: page, Pages: 2}, nil
+	})
+
+	warmer := services.NewCacheWarmer(usersService, limiter, &services.Warming{
+		Pages:    3,
+		PerPage:  20,
+		Interval: time.Hour,
+		Stagger:  time.Millisecond,
+	})
+
+	warmer.Start(context.Background())
+
+	assert.Equal(t, 1, <-warmed)
+	assert.Equal(t, 2, <-warmed)
+
+	warmer.Stop()
+	assert.Empty(t, warmed)
+}
+
+func TestCacheWarmer_Throttled(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	limiter := clientmocks.NewMockIRateLimiter(t)
+
+	throttled := make(chan struct{}, 10)
+
+	limiter.EXPECT().Snapshot().RunAndReturn(func() model.RateLimitDTO {
+		throttled <- struct{}{}
+		return model.RateLimitDTO{Known: true, Throttled: true}
+	})
+
+	warmer := services.NewCacheWarmer(usersService, limiter, &services.Warming{
+		Pages:    3,
+		PerPage:  10,
+		Interval: 10 * time.Millisecond,
+		Stagger:  time.Millisecond,
+	})
+
+	warmer.Start(context.Background())
+
+	<-throttled
+	<-throttled
+
+	warmer.Stop()
+}
+
+func TestCacheWarmer_Reserve(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	limiter := clientmocks.NewMockIRateLimiter(t)
+
+	checked := make(chan struct{}, 10)
+
+	limiter.EXPECT().Snapshot().RunAndReturn(func() model.RateLimitDTO {
+		checked <- struct{}{}
+		return model.RateLimitDTO{Known: true, Limit: 100, Remaining: 50}
+	})
+
+	warmer := services.NewCacheWarmer(usersService, limiter, &services.Warming{
+		Pages:          3,
+		PerPage:        10,
+		Interval:       10 * time.Millisecond,
+		ReservePercent: 50,
+	})
+
+	warmer.Start(context.Background())
+
+	<-checked
+	<-checked
+
+	warmer.Stop()
+}
+
+func TestCacheWarmer_Stop(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	limiter := clientmocks.NewMockIRateLimiter(t)
+
+	warming := make(chan struct{})
+
+	limiter.EXPECT().Snapshot().Return(model.RateLimitDTO{})
+	usersService.EXPECT().GetUsers(mock.Anything, 1, 10).RunAndReturn(func(ctx context.Context, _ int, _ int) (*paging.PagedResultDTO[model.UserDTO], error) {
+		close(warming)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	warmer := services.NewCacheWarmer(usersService, limiter, &services.Warming{
+		Pages:    3,
+		PerPage:  10,
+		Interval: time.Hour,
+		Stagger:  time.Hour,
+	})
+
+	warmer.Start(context.Background())
+	<-warming
+
+	stopped := make(chan struct{})
+	go func() {
+		warmer.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("warmer did not stop while warming")
+	}
+}
+
+func TestCacheWarmer_Disabled(t *testing.T) {
+	warmer := services.NewCacheWarmer(mocks.NewMockIUsersService(t), clientmocks.NewMockIRateLimiter(t), &services.Warming{})
+
+	warmer.Start(context.Background())
+	warmer.Stop()
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"time"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/cache"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+type IChangesService interface {
+	GetChanges(since time.Time, cursor string, limit int) (*model.ChangesDTO, error)
+}
+
+// ChangesService lists what the mirror syncs detected changing upstream, for
+// downstream systems to follow the deltas instead of re-reading everything.
+type ChangesService struct {
+	store *mirror.Store
+}
+
+func NewChangesService(store *mirror.Store) *ChangesService {
+	return &ChangesService{
+		store: store,
+	}
+}
+
+// GetChanges lists up to limit changes after the cursor or, without one,
+// detected after since, oldest first, along with the cursor to ask for the
+// next ones.
+func (r *ChangesService) GetChanges(since time.Time, cursor string, limit int) (*model.ChangesDTO, error) {
+	changeLog, err := r.store.Changes(since, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	changesDTO := &model.ChangesDTO{
+		SyncedAt:   changeLog.SyncedAt,
+		NextCursor: changeLog.Next,
+		HasMore:    changeLog.More,
+		Changes:    make([]model.ChangeDTO, 0, len(changeLog.Changes)),
+	}
+
+	if !changeLog.Since.IsZero() {
+		changesDTO.Since = &changeLog.Since
+	}
+
+	for _, change := range changeLog.Changes {
+		var entity any
+		if err = cache.Unmarshal(change.Entity, &entity); err != nil {
+			return nil, err
+		}
+
+		changesDTO.Changes = append(changesDTO.Changes, model.ChangeDTO{
+			Resource:   change.Resource,
+			ID:         change.ID,
+			Type:       change.Type,
+			DetectedAt: change.DetectedAt,
+			Entity:     entity,
+		})
+	}
+
+	return changesDTO, nil
+}
@@ -0,0 +1,49 @@
+package services_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/mirror"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+)
+
+func TestChangesService_GetChanges(t *testing.T) {
+	store, err := mirror.NewStore(&mirror.Mirroring{
+		Mode:    mirror.ModeLive,
+		Path:    filepath.Join(t.TempDir(), "mirror.db"),
+		Changes: true,
+	})
+	require.NoError(t, err)
+	defer store.Close()
+
+	syncedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	_, err = store.Save(&mirror.Snapshot{SyncedAt: syncedAt})
+	require.NoError(t, err)
+
+	_, err = store.Save(&mirror.Snapshot{
+		SyncedAt: syncedAt.Add(time.Minute),
+		Users:    []model.UserResponse{{ID: 1, Name: "user1"}},
+	})
+	require.NoError(t, err)
+
+	changesDTO, err := services.NewChangesService(store).GetChanges(syncedAt, "", 0)
+	require.NoError(t, err)
+	assert.True(t, changesDTO.SyncedAt.Equal(syncedAt.Add(time.Minute)))
+	require.Len(t, changesDTO.Changes, 1)
+	assert.Equal(t, mirror.ChangeCreated, changesDTO.Changes[0].Type)
+
+	entity, ok := changesDTO.Changes[0].Entity.(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "user1", entity["name"])
+}
+
+func TestChangesService_GetChanges_Disabled(t *testing.T) {
+	_, err := services.NewChangesService(nil).GetChanges(time.Time{}, "", 0)
+	require.ErrorIs(t, err, mirror.ErrChangesDisabled)
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+)
+
+const (
+	commentsPageSize = 100
+
+	CommentsSourceUpstream = "upstream"
+	CommentsSourceScan     = "scan"
+)
+
+type ICommentsService interface {
+	FindByEmail(ctx context.Context, email string) (*model.CommentSearchDTO, error)
+}
+
+type CommentsService struct {
+	userClient  clients.IUserClient
+	concurrency *Concurrency
+}
+
+func NewCommentsService(userClient clients.IUserClient, concurrency *Concurrency) *CommentsService {
+	return &CommentsService{
+		userClient:  userClient,
+		concurrency: concurrency,
+	}
+}
+
+// FindByEmail relies on the upstream email filter, keeping only the comments
+// of email, and only scans every comment when the filter is ignored.
+func (r *CommentsService) FindByEmail(ctx context.Context, email string) (*model.CommentSearchDTO, error) {
+	source := CommentsSourceUpstream
+
+	comments, err := r.findByUpstreamFilter(ctx, email)
+	if errors.Is(err, errFilterIgnored) {
+		source = CommentsSourceScan
+		comments, err = r.findByScan(ctx, email)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.withPosts(ctx, comments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CommentSearchDTO{
+		Email:   email,
+		Source:  source,
+		Total:   len(results),
+		Results: results,
+	}, nil
+}
+
+var errFilterIgnored = errors.New("upstream ignored the email filter")
+
+func (r *CommentsService) findByUpstreamFilter(ctx context.Context, email string) ([]model.CommentResponse, error) {
+	first, err := r.userClient.GetCommentsByEmail(ctx, email, 1, commentsPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ignored, err := r.filterIgnored(ctx, email, first)
+	if err != nil {
+		return nil, err
+	}
+	if ignored {
+		return nil, errFilterIgnored
+	}
+
+	return scanComments(func(page int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+		if page == 1 {
+			return first, nil
+		}
+		return r.userClient.GetCommentsByEmail(ctx, email, page, commentsPageSize)
+	}, matchEmail(email))
+}
+
+// filterIgnored tells apart an upstream ignoring the filter from one matching
+// emails partially when the first filtered page has comments of other emails,
+// the filter being ignored when there are as many comments without it.
+func (r *CommentsService) filterIgnored(ctx context.Context, email string, filtered *paging.PagedResultResponse[model.CommentResponse]) (bool, error) {
+	match := matchEmail(email)
+	if !slices.ContainsFunc(filtered.Results, func(comment model.CommentResponse) bool { return !match(&comment) }) {
+		return false, nil
+	}
+
+	unfiltered, err := r.userClient.GetAllComments(ctx, 1, commentsPageSize)
+	if err != nil {
+		return false, err
+	}
+
+	return unfiltered.Total == filtered.Total, nil
+}
+
+func (r *CommentsService) findByScan(ctx context.Context, email string) ([]model.CommentResponse, error) {
+	return scanComments(func(page int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+		return r.userClient.GetAllComments(ctx, page, commentsPageSize)
+	}, matchEmail(email))
+}
+
+func matchEmail(email string) func(comment *model.CommentResponse) bool {
+	return func(comment *model.CommentResponse) bool {
+		return strings.EqualFold(comment.Email, email)
+	}
+}
+
+func scanComments(
+	fetch func(page int) (*paging.PagedResultResponse[model.CommentResponse], error),
+	match func(comment *model.CommentResponse) bool) ([]model.CommentResponse, error) {
+	var comments []model.CommentResponse
+
+	for page, pages := 1, 1; page <= pages; page++ {
+		pagedResult, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < len(pagedResult.Results); i++ {
+			if match(&pagedResult.Results[i]) {
+				comments = append(comments, pagedResult.Results[i])
+			}
+		}
+
+		pages = pagedResult.Pages
+	}
+
+	return comments, nil
+}
+
+func (r *CommentsService) withPosts(ctx context.Context, comments []model.CommentResponse) ([]model.CommentSearchResultDTO, error) {
+	postIDs := make([]int, len(comments))
+	for i := 0; i < len(comments); i++ {
+		postIDs[i] = comments[i].PostID
+	}
+	postIDs = distinct(postIDs)
+
+	postResponses, err := tpl.MapErr(ctx, postIDs, func(ctx context.Context, postID *int) (*model.PostResponse, error) {
+		postResponse, err := r.userClient.GetPost(ctx, *postID)
+		if errors.Is(err, clients.ErrNotFound) {
+			return nil, nil
+		}
+
+		return postResponse, err
+	}, r.concurrency.Posts)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make(map[int]*model.PostResponse, len(postIDs))
+	for i, postResponse := range postResponses {
+		if postResponse != nil {
+			posts[postIDs[i]] = postResponse
+		}
+	}
+
+	var userIDs []int
+	for _, post := range posts {
+		userIDs = append(userIDs, post.UserID)
+	}
+
+	authors, err := getAuthors(ctx, r.userClient, userIDs, r.concurrency.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]model.CommentSearchResultDTO, len(comments))
+	for i := 0; i < len(comments); i++ {
+		results[i] = model.CommentSearchResultDTO{
+			ID:    comments[i].ID,
+			Name:  comments[i].Name,
+			Email: comments[i].Email,
+			Body:  comments[i].Body,
+		}
+
+		if post, found := posts[comments[i].PostID]; found {
+			results[i].Post = &model.CommentPostDTO{
+				ID:     post.ID,
+				Title:  post.Title,
+				Author: authors[post.UserID],
+			}
+		}
+	}
+
+	return results, nil
+}
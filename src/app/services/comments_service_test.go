@@ -0,0 +1,111 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+)
+
+const commenterEmail = "john@example.com"
+
+func TestCommentsService_FindByEmail(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetCommentsByEmail(mock.Anything, commenterEmail, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{
+		Page:    1,
+		Pages:   1,
+		Results: []model.CommentResponse{{ID: 1, PostID: 10, Email: commenterEmail}, {ID: 2, PostID: 20, Email: commenterEmail}},
+	}, nil)
+	userClient.EXPECT().GetPost(mock.Anything, 10).Return(&model.PostResponse{ID: 10, UserID: 1, Title: "post10"}, nil)
+	userClient.EXPECT().GetPost(mock.Anything, 20).Return(nil, clients.ErrNotFound)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "Jane"}, nil)
+
+	actual, err := services.NewCommentsService(userClient, concurrency).FindByEmail(context.Background(), commenterEmail)
+
+	require.NoError(t, err)
+	assert.Equal(t, services.CommentsSourceUpstream, actual.Source)
+	assert.Equal(t, 2, actual.Total)
+	assert.Equal(t, "post10", actual.Results[0].Post.Title)
+	assert.Equal(t, "Jane", actual.Results[0].Post.Author.Name)
+	assert.Nil(t, actual.Results[1].Post)
+}
+
+func TestCommentsService_FindByEmail_Scan(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetCommentsByEmail(mock.Anything, commenterEmail, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{
+		Page:    1,
+		Pages:   2,
+		Total:   2,
+		Results: []model.CommentResponse{{ID: 1, PostID: 10, Email: "other@example.com"}},
+	}, nil)
+	userClient.EXPECT().GetAllComments(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{
+		Page:    1,
+		Pages:   2,
+		Total:   2,
+		Results: []model.CommentResponse{{ID: 1, PostID: 10, Email: "other@example.com"}},
+	}, nil)
+	userClient.EXPECT().GetAllComments(mock.Anything, 2, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{
+		Page:    2,
+		Pages:   2,
+		Total:   2,
+		Results: []model.CommentResponse{{ID: 3, PostID: 10, Email: "John@Example.com"}},
+	}, nil)
+	userClient.EXPECT().GetPost(mock.Anything, 10).Return(&model.PostResponse{ID: 10, UserID: 1, Title: "post10"}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(nil, clients.ErrNotFound)
+
+	actual, err := services.NewCommentsService(userClient, concurrency).FindByEmail(context.Background(), commenterEmail)
+
+	require.NoError(t, err)
+	assert.Equal(t, services.CommentsSourceScan, actual.Source)
+	require.Len(t, actual.Results, 1)
+	assert.Equal(t, 3, actual.Results[0].ID)
+	assert.Equal(t, "post10", actual.Results[0].Post.Title)
+	assert.Nil(t, actual.Results[0].Post.Author)
+}
+
+func TestCommentsService_FindByEmail_PartialMatch(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetCommentsByEmail(mock.Anything, commenterEmail, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{
+		Page:    1,
+		Pages:   1,
+		Total:   2,
+		Results: []model.CommentResponse{{ID: 1, PostID: 10, Email: "john@example.com.au"}, {ID: 2, PostID: 10, Email: "John@Example.com"}},
+	}, nil)
+	userClient.EXPECT().GetAllComments(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.CommentResponse]{
+		Page:    1,
+		Pages:   5,
+		Total:   500,
+		Results: []model.CommentResponse{{ID: 1, PostID: 10, Email: "john@example.com.au"}},
+	}, nil)
+	userClient.EXPECT().GetPost(mock.Anything, 10).Return(&model.PostResponse{ID: 10, UserID: 1, Title: "post10"}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "Jane"}, nil)
+
+	actual, err := services.NewCommentsService(userClient, concurrency).FindByEmail(context.Background(), commenterEmail)
+
+	require.NoError(t, err)
+	assert.Equal(t, services.CommentsSourceUpstream, actual.Source)
+	require.Len(t, actual.Results, 1)
+	assert.Equal(t, 2, actual.Results[0].ID)
+}
+
+func TestCommentsService_FindByEmail_Err(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetCommentsByEmail(mock.Anything, commenterEmail, 1, 100).Return(nil, errors.New("some error"))
+
+	actual, err := services.NewCommentsService(userClient, concurrency).FindByEmail(context.Background(), commenterEmail)
+
+	require.Error(t, err)
+	assert.Nil(t, actual)
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"fmt"
+
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+	"go.uber.org/multierr"
+)
+
+const minConcurrency = 1
+
+// Concurrency is the number of upstream calls fanned out at once per relation.
+type Concurrency struct {
+	Users    int
+	Posts    int
+	Todos    int
+	Comments int
+}
+
+func NewConcurrency() (*Concurrency, error) {
+	concurrency := &Concurrency{
+		Users:    config.TryInt("concurrency.users", 4),
+		Posts:    config.TryInt("concurrency.posts", 4),
+		Todos:    config.TryInt("concurrency.todos", 4),
+		Comments: config.TryInt("concurrency.comments", 8),
+	}
+
+	if err := concurrency.Validate(); err != nil {
+		return nil, err
+	}
+
+	return concurrency, nil
+}
+
+func (r *Concurrency) Validate() error {
+	var err error
+
+	for _, limit := range []struct {
+		relation string
+		value    int
+	}{
+		{"users", r.Users},
+		{"posts", r.Posts},
+		{"todos", r.Todos},
+		{"comments", r.Comments},
+	} {
+		if limit.value < minConcurrency {
+			err = multierr.Append(err, fmt.Errorf("concurrency.%s must be at least %d, got %d", limit.relation, minConcurrency, limit.value))
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+	"go.uber.org/multierr"
+)
+
+const feedPageSize = 100
+
+type IFeedService interface {
+	GetFeed(ctx context.Context, cursor model.Cursor, limit int) (*model.FeedDTO, error)
+}
+
+type FeedService struct {
+	userClient  clients.IUserClient
+	concurrency *Concurrency
+}
+
+func NewFeedService(userClient clients.IUserClient, concurrency *Concurrency) *FeedService {
+	return &FeedService{
+		userClient:  userClient,
+		concurrency: concurrency,
+	}
+}
+
+// GetFeed returns the posts across all users in the upstream order, newest
+// first, from the cursor on.
+func (r *FeedService) GetFeed(ctx context.Context, cursor model.Cursor, limit int) (*model.FeedDTO, error) {
+	posts, next, err := r.getPosts(ctx, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	feedPosts := make([]model.FeedPostDTO, len(posts))
+	for i := 0; i < len(posts); i++ {
+		feedPosts[i] = model.FeedPostDTO{
+			ID:    posts[i].ID,
+			Title: posts[i].Title,
+			Body:  posts[i].Body,
+		}
+	}
+
+	pool := tpl.New().WithMaxGoroutines(2)
+
+	var authors map[int]*model.AuthorDTO
+	var authorsErr, commentsErr error
+
+	pool.Submit(func() {
+		userIDs := make([]int, len(posts))
+		for i := 0; i < len(posts); i++ {
+			userIDs[i] = posts[i].UserID
+		}
+
+		authors, authorsErr = getAuthors(ctx, r.userClient, userIDs, r.concurrency.Users)
+	})
+
+	pool.Submit(func() {
+		commentsErr = r.countComments(ctx, feedPosts)
+	})
+
+	if err = multierr.Combine(pool.Wait(), authorsErr, commentsErr); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(posts); i++ {
+		feedPosts[i].Author = authors[posts[i].UserID]
+	}
+
+	feed := &model.FeedDTO{
+		Limit:   limit,
+		Results: feedPosts,
+	}
+
+	if next != nil {
+		feed.NextCursor = model.EncodeCursor(*next)
+	}
+
+	return feed, nil
+}
+
+// getPosts reads limit posts from the upstream page and offset of the cursor,
+// returning the cursor to the post after them when there are more.
+func (r *FeedService) getPosts(ctx context.Context, cursor model.Cursor, limit int) ([]model.PostResponse, *model.Cursor, error) {
+	var posts []model.PostResponse
+
+	page, offset := max(cursor.Page, 1), cursor.Offset
+
+	for {
+		pagedResult, err := r.userClient.GetAllPosts(ctx, page, feedPageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		results := pagedResult.Results
+		start := min(offset, len(results))
+
+		// Posts created or deleted since the cursor shift the page, so resume
+		// after the last post returned whenever it is still in the page.
+		if cursor.ID != 0 && page == cursor.Page {
+			if i := slices.IndexFunc(results, func(post model.PostResponse) bool { return post.ID == cursor.ID }); i >= 0 {
+				start = i + 1
+			}
+		}
+
+		for i := start; i < len(results); i++ {
+			if len(posts) == limit {
+				return posts, &model.Cursor{Page: page, Offset: i, ID: posts[len(posts)-1].ID}, nil
+			}
+
+			posts = append(posts, results[i])
+		}
+
+		if page >= pagedResult.Pages {
+			return posts, nil, nil
+		}
+
+		page, offset = page+1, 0
+	}
+}
+
+func (r *FeedService) countComments(ctx context.Context, feedPosts []model.FeedPostDTO) error {
+	counts, err := tpl.MapErr(ctx, feedPosts, func(ctx context.Context, feedPost *model.FeedPostDTO) (int, error) {
+		comments, err := r.userClient.GetComments(ctx, feedPost.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(comments), nil
+	}, r.concurrency.Comments)
+
+	for i, count := range counts {
+		feedPosts[i].CommentCount = count
+	}
+
+	return err
+}
@@ -0,0 +1,112 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+)
+
+func TestFeedService_GetFeed(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetAllPosts(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.PostResponse]{
+		Page:    1,
+		Pages:   2,
+		Results: []model.PostResponse{{ID: 10, UserID: 1}, {ID: 9, UserID: 2}, {ID: 8, UserID: 1}},
+	}, nil)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "John"}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(nil, clients.ErrNotFound)
+	userClient.EXPECT().GetComments(mock.Anything, 10).Return([]model.CommentResponse{{ID: 1}, {ID: 2}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 9).Return([]model.CommentResponse{}, nil)
+
+	actual, err := services.NewFeedService(userClient, concurrency).GetFeed(context.Background(), model.Cursor{Page: 1}, 2)
+
+	require.NoError(t, err)
+	require.Len(t, actual.Results, 2)
+	assert.Equal(t, 10, actual.Results[0].ID)
+	assert.Equal(t, 2, actual.Results[0].CommentCount)
+	assert.Equal(t, "John", actual.Results[0].Author.Name)
+	assert.Equal(t, 9, actual.Results[1].ID)
+	assert.Nil(t, actual.Results[1].Author)
+
+	cursor, err := model.DecodeCursor(actual.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, model.Cursor{Page: 1, Offset: 2, ID: 9}, cursor)
+}
+
+func TestFeedService_GetFeed_Cursor(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetAllPosts(mock.Anything, 2, 100).Return(&paging.PagedResultResponse[model.PostResponse]{
+		Page:    2,
+		Pages:   3,
+		Results: []model.PostResponse{{ID: 4, UserID: 1}, {ID: 3, UserID: 1}},
+	}, nil)
+	userClient.EXPECT().GetAllPosts(mock.Anything, 3, 100).Return(&paging.PagedResultResponse[model.PostResponse]{
+		Page:    3,
+		Pages:   3,
+		Results: []model.PostResponse{{ID: 8, UserID: 1}},
+	}, nil)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 3).Return(nil, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 8).Return(nil, nil)
+
+	actual, err := services.NewFeedService(userClient, concurrency).GetFeed(context.Background(), model.Cursor{Page: 2, Offset: 1, ID: 9}, 5)
+
+	require.NoError(t, err)
+	require.Len(t, actual.Results, 2)
+	assert.Equal(t, 3, actual.Results[0].ID)
+	assert.Equal(t, 8, actual.Results[1].ID)
+	assert.Empty(t, actual.NextCursor)
+}
+
+func TestFeedService_GetFeed_Cursor_Shifted(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetAllPosts(mock.Anything, 2, 100).Return(&paging.PagedResultResponse[model.PostResponse]{
+		Page:    2,
+		Pages:   2,
+		Results: []model.PostResponse{{ID: 10, UserID: 1}, {ID: 9, UserID: 1}, {ID: 8, UserID: 1}, {ID: 7, UserID: 1}},
+	}, nil)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 8).Return(nil, nil)
+
+	actual, err := services.NewFeedService(userClient, concurrency).GetFeed(context.Background(), model.Cursor{Page: 2, Offset: 1, ID: 9}, 1)
+
+	require.NoError(t, err)
+	require.Len(t, actual.Results, 1)
+	assert.Equal(t, 8, actual.Results[0].ID)
+
+	cursor, err := model.DecodeCursor(actual.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, model.Cursor{Page: 2, Offset: 3, ID: 8}, cursor)
+}
+
+func TestFeedService_GetFeed_Err(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetAllPosts(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.PostResponse]{
+		Page:    1,
+		Pages:   1,
+		Results: []model.PostResponse{{ID: 10, UserID: 1}},
+	}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 10).Return(nil, errors.New("some error"))
+
+	actual, err := services.NewFeedService(userClient, concurrency).GetFeed(context.Background(), model.Cursor{Page: 1}, 10)
+
+	require.Error(t, err)
+	assert.Nil(t, actual)
+}
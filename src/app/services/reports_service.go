@@ -0,0 +1,123 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+)
+
+const reportsPageSize = 100
+
+type IReportsService interface {
+	GetOverdueTodos(ctx context.Context) (*model.OverdueTodosReportDTO, error)
+}
+
+type ReportsService struct {
+	userClient  clients.IUserClient
+	concurrency *Concurrency
+	now         func() time.Time
+}
+
+func NewReportsService(userClient clients.IUserClient, concurrency *Concurrency) *ReportsService {
+	return &ReportsService{
+		userClient:  userClient,
+		concurrency: concurrency,
+		now:         time.Now,
+	}
+}
+
+// GetOverdueTodos walks the todos of every user, only fetching users and todos,
+// and groups the pending ones past their due date by user, most overdue first.
+func (r *ReportsService) GetOverdueTodos(ctx context.Context) (*model.OverdueTodosReportDTO, error) {
+	now := r.now()
+
+	report := &model.OverdueTodosReportDTO{
+		GeneratedAt: now,
+		Results:     make([]model.OverdueUserDTO, 0),
+	}
+
+	for page, pages := 1, 1; page <= pages; page++ {
+		pagedResult, err := r.userClient.GetUsers(ctx, page, reportsPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		overdueUsers, err := r.getOverdueUsers(ctx, pagedResult.Results, now)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Results = append(report.Results, overdueUsers...)
+		pages = pagedResult.Pages
+	}
+
+	slices.SortFunc(report.Results, func(a, b model.OverdueUserDTO) int {
+		if c := a.Todos[0].DueOn.Compare(b.Todos[0].DueOn); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+
+	report.Users = len(report.Results)
+	for _, user := range report.Results {
+		report.Todos += len(user.Todos)
+	}
+
+	return report, nil
+}
+
+func (r *ReportsService) getOverdueUsers(ctx context.Context, userResponses []model.UserResponse, now time.Time) ([]model.OverdueUserDTO, error) {
+	users, err := tpl.MapErr(ctx, userResponses, func(ctx context.Context, userResponse *model.UserResponse) (*model.OverdueUserDTO, error) {
+		todoResponses, err := r.userClient.GetTodos(ctx, userResponse.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		var todos []model.OverdueTodoDTO
+		for _, todoResponse := range todoResponses {
+			todo := model.TodoDTO{DueOn: todoResponse.DueOn, Status: todoResponse.Status}
+			if !todo.IsOverdue(now) {
+				continue
+			}
+
+			todos = append(todos, model.OverdueTodoDTO{
+				ID:          todoResponse.ID,
+				Title:       todoResponse.Title,
+				DueOn:       todoResponse.DueOn,
+				OverdueDays: int(now.Sub(todoResponse.DueOn).Hours() / 24),
+			})
+		}
+
+		if len(todos) == 0 {
+			return nil, nil
+		}
+
+		slices.SortFunc(todos, func(a, b model.OverdueTodoDTO) int {
+			if c := a.DueOn.Compare(b.DueOn); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.ID, b.ID)
+		})
+
+		return &model.OverdueUserDTO{
+			UserID: userResponse.ID,
+			Name:   userResponse.Name,
+			Email:  userResponse.Email,
+			Todos:  todos,
+		}, nil
+	}, r.concurrency.Todos)
+
+	var overdueUsers []model.OverdueUserDTO
+	for _, user := range users {
+		if user != nil {
+			overdueUsers = append(overdueUsers, *user)
+		}
+	}
+
+	return overdueUsers, err
+}
@@ -0,0 +1,82 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
+)
+
+func TestReportsService_GetOverdueTodos(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+	now := time.Now()
+
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page:    1,
+		Pages:   2,
+		Results: []model.UserResponse{{ID: 1, Name: "John"}, {ID: 2, Name: "Jane"}},
+	}, nil)
+	userClient.EXPECT().GetUsers(mock.Anything, 2, 100).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page:    2,
+		Pages:   2,
+		Results: []model.UserResponse{{ID: 3, Name: "Joe", Email: "joe@example.com"}},
+	}, nil)
+
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{
+		{ID: 1, Status: "pending", DueOn: now.Add(-48 * time.Hour)},
+		{ID: 2, Status: "pending", DueOn: now.Add(time.Hour)},
+		{ID: 3, Status: "completed", DueOn: now.Add(-72 * time.Hour)},
+	}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 2).Return([]model.TodoResponse{}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 3).Return([]model.TodoResponse{
+		{ID: 4, Title: "todo4", Status: "pending", DueOn: now.Add(-time.Hour)},
+		{ID: 5, Title: "todo5", Status: "pending", DueOn: now.Add(-96 * time.Hour)},
+	}, nil)
+
+	actual, err := services.NewReportsService(userClient, concurrency).GetOverdueTodos(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, actual.Users)
+	assert.Equal(t, 3, actual.Todos)
+	require.Len(t, actual.Results, 2)
+
+	assert.Equal(t, "Joe", actual.Results[0].Name)
+	require.Len(t, actual.Results[0].Todos, 2)
+	assert.Equal(t, 5, actual.Results[0].Todos[0].ID)
+	assert.Equal(t, 4, actual.Results[0].Todos[0].OverdueDays)
+	assert.Equal(t, 4, actual.Results[0].Todos[1].ID)
+	assert.Equal(t, 0, actual.Results[0].Todos[1].OverdueDays)
+
+	assert.Equal(t, "John", actual.Results[1].Name)
+	require.Len(t, actual.Results[1].Todos, 1)
+	assert.Equal(t, 1, actual.Results[1].Todos[0].ID)
+
+	records, err := actual.MarshalCSV()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{"3", "Joe", "joe@example.com", "5", "todo5", actual.Results[0].Todos[0].DueOn.Format(time.RFC3339), "4"}, records[1])
+}
+
+func TestReportsService_GetOverdueTodos_Err(t *testing.T) {
+	userClient := mocks.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 100).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page:    1,
+		Pages:   1,
+		Results: []model.UserResponse{{ID: 1}},
+	}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return(nil, errors.New("some error"))
+
+	actual, err := services.NewReportsService(userClient, concurrency).GetOverdueTodos(context.Background())
+
+	require.Error(t, err)
+	assert.Nil(t, actual)
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/search"
+	log "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-logger"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
+)
+
+var (
+	ErrSearchIndexPending = errors.New("search index is being built")
+	ErrSearchDisabled     = errors.New("search is disabled")
+)
+
+var (
+	searchIndexDocuments = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "search",
+		Name:      "index_documents",
+		Help:      "Number of posts and comments in the search index.",
+	})
+	searchIndexTerms = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "search",
+		Name:      "index_terms",
+		Help:      "Number of distinct terms in the search index.",
+	})
+	searchIndexRefreshed = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gorest_api",
+		Subsystem: "search",
+		Name:      "index_last_refresh_timestamp_seconds",
+		Help:      "Unix time of the last successful index refresh, staleness being time() minus this value.",
+	})
+)
+
+type ISearchService interface {
+	Search(query string, limit int) (*model.SearchDTO, error)
+	Refresh(ctx context.Context) error
+}
+
+type ISearchIndexer interface {
+	Start(ctx context.Context)
+	Stop()
+}
+
+// SearchService keeps an in-process index of every post and comment, rebuilt
+// in the background from a full users scan every refresh interval, and retried
+// sooner, backing off, while the scan fails. With search.enabled off nothing is
+// scanned and searches are refused.
+type SearchService struct {
+	usersService    IUsersService
+	enabled         bool
+	index           *search.Index
+	refreshInterval time.Duration
+	retry           backoff
+
+	mtx         sync.Mutex
+	refreshedAt time.Time
+	refreshing  bool
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func NewSearchService(usersService IUsersService) *SearchService {
+	refreshInterval := time.Duration(config.TryInt("search.refresh-interval", 600000)) * time.Millisecond
+
+	return &SearchService{
+		usersService:    usersService,
+		enabled:         config.TryBool("search.enabled", true),
+		index:           search.NewIndex(),
+		refreshInterval: refreshInterval,
+		retry:           newBackoff(time.Duration(config.TryInt("search.retry-delay", 5000))*time.Millisecond, refreshInterval),
+	}
+}
+
+func (r *SearchService) Start(ctx context.Context) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if !r.enabled || r.cancel != nil {
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		for {
+			wait := r.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		}
+	}()
+}
+
+// Stop cancels the refresh in progress, keeping the current index, and waits
+// for the indexer to return.
+func (r *SearchService) Stop() {
+	r.mtx.Lock()
+	cancel, done := r.cancel, r.done
+	r.mtx.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+// run refreshes the index, returning how long to wait before the next refresh.
+func (r *SearchService) run(ctx context.Context) time.Duration {
+	r.mtx.Lock()
+	r.refreshing = true
+	r.mtx.Unlock()
+
+	err := r.Refresh(clients.WithCaller(ctx))
+	now := time.Now()
+
+	r.mtx.Lock()
+	r.refreshing = false
+	r.mtx.Unlock()
+
+	switch {
+	case err == nil:
+		r.retry.succeeded()
+		return r.refreshInterval
+	case ctx.Err() != nil:
+		return 0
+	default:
+		log.Errorf("search index refresh failed: %v", err)
+		r.retry.failed(now)
+		return r.retry.retryAt.Sub(now)
+	}
+}
+
+func (r *SearchService) Search(query string, limit int) (*model.SearchDTO, error) {
+	if !r.enabled {
+		return nil, ErrSearchDisabled
+	}
+
+	r.mtx.Lock()
+	refreshedAt, refreshing := r.refreshedAt, r.refreshing
+	r.mtx.Unlock()
+
+	if refreshedAt.IsZero() {
+		return nil, ErrSearchIndexPending
+	}
+
+	hits, total := r.index.Search(query, limit)
+
+	results := make([]model.SearchHitDTO, len(hits))
+	for i := 0; i < len(hits); i++ {
+		results[i] = model.SearchHitDTO{
+			Kind:    string(hits[i].Document.Kind),
+			ID:      hits[i].Document.EntityID,
+			PostID:  hits[i].Document.PostID,
+			UserID:  hits[i].Document.UserID,
+			Title:   hits[i].Document.Title,
+			Snippet: hits[i].Snippet,
+			Score:   hits[i].Score,
+		}
+	}
+
+	return &model.SearchDTO{
+		Query:   query,
+		Total:   total,
+		Results: results,
+		Index: model.SearchIndexDTO{
+			Documents:        r.index.Documents(),
+			Terms:            r.index.Terms(),
+			RefreshedAt:      refreshedAt,
+			StalenessSeconds: time.Since(refreshedAt).Seconds(),
+			Refreshing:       refreshing,
+		},
+	}, nil
+}
+
+func (r *SearchService) Refresh(ctx context.Context) error {
+	var docs []search.Document
+
+	if err := r.usersService.ScanUsers(ctx, 100, func(users []model.UserDTO) error {
+		docs = append(docs, documents(users)...)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	r.index.Replace(docs)
+
+	now := time.Now()
+
+	r.mtx.Lock()
+	r.refreshedAt = now
+	r.mtx.Unlock()
+
+	searchIndexDocuments.Set(float64(r.index.Documents()))
+	searchIndexTerms.Set(float64(r.index.Terms()))
+	searchIndexRefreshed.Set(float64(now.Unix()))
+
+	return nil
+}
+
+func documents(users []model.UserDTO) []search.Document {
+	var docs []search.Document
+
+	for i := 0; i < len(users); i++ {
+		for _, post := range users[i].Posts {
+			docs = append(docs, search.Document{
+				ID:       string(search.KindPost) + ":" + strconv.Itoa(post.ID),
+				Kind:     search.KindPost,
+				EntityID: post.ID,
+				PostID:   post.ID,
+				UserID:   users[i].ID,
+				Title:    post.Title,
+				Body:     post.Body,
+			})
+
+			for _, comment := range post.Comments {
+				docs = append(docs, search.Document{
+					ID:       string(search.KindComment) + ":" + strconv.Itoa(comment.ID),
+					Kind:     search.KindComment,
+					EntityID: comment.ID,
+					PostID:   post.ID,
+					UserID:   users[i].ID,
+					Body:     comment.Body,
+				})
+			}
+		}
+	}
+
+	return docs
+}
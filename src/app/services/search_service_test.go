@@ -0,0 +1,73 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	mocks "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/services"
+)
+
+func TestSearchService_Search(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	usersService.EXPECT().ScanUsers(mock.Anything, 100, mock.Anything).RunAndReturn(func(_ context.Context, _ int, f func([]model.UserDTO) error) error {
+		return f([]model.UserDTO{{
+			ID: 1,
+			Posts: []model.PostDTO{
+				{ID: 10, Title: "Concurrency in Go", Body: "Pools and pipelines.", Comments: []model.CommentDTO{
+					{ID: 100, PostID: 10, Body: "Pipelines rock."},
+				}},
+			},
+		}})
+	})
+
+	searchService := services.NewSearchService(usersService)
+	require.NoError(t, searchService.Refresh(context.Background()))
+
+	actual, err := searchService.Search("pipelines", 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, actual.Total)
+	require.Len(t, actual.Results, 2)
+	assert.Equal(t, model.SearchHitDTO{
+		Kind:    "comment",
+		ID:      100,
+		PostID:  10,
+		UserID:  1,
+		Snippet: "<em>Pipelines</em> rock.",
+		Score:   actual.Results[0].Score,
+	}, actual.Results[0])
+	assert.Equal(t, "post", actual.Results[1].Kind)
+	assert.Equal(t, 2, actual.Index.Documents)
+	assert.False(t, actual.Index.RefreshedAt.IsZero())
+}
+
+func TestSearchService_Search_Pending(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+
+	actual, err := services.NewSearchService(usersService).Search("pipelines", 10)
+
+	require.ErrorIs(t, err, services.ErrSearchIndexPending)
+	assert.Nil(t, actual)
+}
+
+func TestSearchService_Start(t *testing.T) {
+	usersService := mocks.NewMockIUsersService(t)
+	usersService.EXPECT().ScanUsers(mock.Anything, 100, mock.Anything).RunAndReturn(func(_ context.Context, _ int, f func([]model.UserDTO) error) error {
+		return f([]model.UserDTO{{ID: 1, Posts: []model.PostDTO{{ID: 10, Title: "Concurrency in Go"}}}})
+	}).Once()
+
+	searchService := services.NewSearchService(usersService)
+	searchService.Start(context.Background())
+	defer searchService.Stop()
+
+	assert.Eventually(t, func() bool {
+		actual, err := searchService.Search("concurrency", 10)
+		return err == nil && actual.Total == 1
+	}, time.Second, 10*time.Millisecond)
+}
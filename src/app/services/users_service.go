@@ -2,292 +2,447 @@ package services
 
 import (
 	"cmp"
-	"runtime"
+	"context"
+	"errors"
+	"fmt"
 	"slices"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
+	config "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-sdk-config"
 
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
 
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/clients"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
-	"go.uber.org/multierr"
 )
 
 type IUsersService interface {
-	GetUsers(page int, perPage int) (*paging.PagedResultDTO[model.UserDTO], error)
+	GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultDTO[model.UserDTO], error)
+	GetUser(ctx context.Context, userID int) (*model.UserDTO, error)
+	GetUsersStats(ctx context.Context, page int, perPage int) (*paging.PagedResultDTO[model.UserStatsDTO], error)
+	GetUserStats(ctx context.Context, userID int) (*model.UserStatsDTO, error)
+	ScanUsers(ctx context.Context, perPage int, f func(users []model.UserDTO) error) error
 }
 
+// ErrBudgetExceeded is returned when the budget runs out before the user itself
+// is read, there being nothing to answer with.
+var ErrBudgetExceeded = errors.New("the aggregation budget ran out")
+
+// The sources the relations of the users are collected under.
+const (
+	userSource     = "user/%d"
+	postsSource    = "user/%d/posts"
+	todosSource    = "user/%d/todos"
+	commentsSource = "post/%d/comments"
+)
+
+var partialAggregations = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "gorest_api",
+	Subsystem: "users",
+	Name:      "partial_aggregations_total",
+	Help:      "Aggregations answered with pending relations because the budget ran out.",
+})
+
 type UsersService struct {
-	userClient clients.IUserClient
+	userClient    clients.IUserClient
+	concurrency   *Concurrency
+	defaultBudget time.Duration
+	maxBudget     time.Duration
 }
 
-func NewUserService(userClient clients.IUserClient) *UsersService {
+func NewUserService(userClient clients.IUserClient, concurrency *Concurrency) *UsersService {
 	return &UsersService{
-		userClient: userClient,
+		userClient:    userClient,
+		concurrency:   concurrency,
+		defaultBudget: time.Duration(config.TryInt("aggregation.budget", 2500)) * time.Millisecond,
+		maxBudget:     time.Duration(config.TryInt("aggregation.max-budget", 10000)) * time.Millisecond,
 	}
 }
 
-func (r *UsersService) GetUsers(page int, perPage int) (*paging.PagedResultDTO[model.UserDTO], error) {
-	pagedResult, aggErr := r.userClient.GetUsers(page, perPage)
-	if aggErr != nil {
-		return nil, aggErr
+// GetUsers reads the page, then aggregates its users within the request budget,
+// falling back to the page's own user fields for the users not read by then.
+func (r *UsersService) GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultDTO[model.UserDTO], error) {
+	pagedResult, err := r.userClient.GetUsers(ctx, page, perPage)
+	if err != nil {
+		return nil, err
 	}
 
-	pool := tpl.NewWorkerPool41[model.UserResponse, model.UserDTO, model.PostDTO, model.TodoDTO]()
+	users, err := r.aggregate(ctx, r.deadline(ctx), pagedResult.Results, true)
+	if err != nil {
+		return nil, err
+	}
 
-	users, err := pool.Zip(pagedResult.Results, r.getUsers, r.getPosts, r.getTodos,
-		func(usersDTOs []model.UserDTO, postDTOs []model.PostDTO, todoDTOs []model.TodoDTO, err error) ([]model.UserDTO, error) {
-			if err != nil {
-				aggErr = multierr.Append(aggErr, err)
-			}
+	return &paging.PagedResultDTO[model.UserDTO]{
+		Limit:   pagedResult.Limit,
+		Page:    pagedResult.Page,
+		Pages:   pagedResult.Pages,
+		Total:   pagedResult.Total,
+		Results: users,
+	}, nil
+}
 
-			var users []model.UserDTO
-			for i := 0; i < len(usersDTOs); i++ {
-				userDTO := &usersDTOs[i]
-
-				userDTO.Posts = make([]model.PostDTO, 0)
-				for k := 0; k < len(postDTOs); k++ {
-					postDTO := postDTOs[k]
-					if postDTO.UserID == userDTO.ID {
-						userDTO.Posts = append(userDTO.Posts, postDTO)
-					}
-				}
-
-				userDTO.Todos = make([]model.TodoDTO, 0)
-				for k := 0; k < len(todoDTOs); k++ {
-					todoDTO := todoDTOs[k]
-					if todoDTO.UserID == userDTO.ID {
-						userDTO.Todos = append(userDTO.Todos, todoDTO)
-					}
-				}
-
-				users = append(users, *userDTO)
-			}
+func (r *UsersService) GetUser(ctx context.Context, userID int) (*model.UserDTO, error) {
+	users, err := r.aggregate(ctx, r.deadline(ctx), []model.UserResponse{{ID: userID}}, false)
+	if err != nil {
+		return nil, err
+	}
 
-			slices.SortFunc(users, func(a, b model.UserDTO) int {
-				return cmp.Compare(a.ID, b.ID)
-			})
+	if len(users) == 0 {
+		return nil, fmt.Errorf("%w: user %d", clients.ErrNotFound, userID)
+	}
 
-			return users, err
-		})
+	return &users[0], nil
+}
 
+func (r *UsersService) GetUsersStats(ctx context.Context, page int, perPage int) (*paging.PagedResultDTO[model.UserStatsDTO], error) {
+	pagedResult, err := r.GetUsers(ctx, page, perPage)
 	if err != nil {
 		return nil, err
 	}
 
-	return &paging.PagedResultDTO[model.UserDTO]{
+	now := time.Now()
+
+	stats := make([]model.UserStatsDTO, len(pagedResult.Results))
+	for i := 0; i < len(pagedResult.Results); i++ {
+		stats[i] = model.NewUserStatsDTO(&pagedResult.Results[i], now)
+	}
+
+	return &paging.PagedResultDTO[model.UserStatsDTO]{
 		Limit:   pagedResult.Limit,
 		Page:    pagedResult.Page,
 		Pages:   pagedResult.Pages,
 		Total:   pagedResult.Total,
-		Results: users,
+		Results: stats,
 	}, nil
 }
 
-func (r *UsersService) getPosts(userResponses []model.UserResponse) ([]model.PostDTO, error) {
-	var (
-		posts  []model.PostDTO
-		aggErr error
-	)
+func (r *UsersService) GetUserStats(ctx context.Context, userID int) (*model.UserStatsDTO, error) {
+	user, err := r.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
-	rChan := make(chan tpl.Task[[]model.PostResponse], len(userResponses))
+	stats := model.NewUserStatsDTO(user, time.Now())
 
-	pool := tpl.New().WithMaxGoroutines(2)
+	return &stats, nil
+}
 
-	pool.Submit(func() {
-		for i := 0; i < len(userResponses); i++ {
-			task := <-rChan
-			if task.Err != nil {
-				aggErr = multierr.Append(aggErr, task.Err)
-				continue
-			}
+// ScanUsers aggregates every page of users in full, handing each page to f in
+// order.
+func (r *UsersService) ScanUsers(ctx context.Context, perPage int, f func(users []model.UserDTO) error) error {
+	ctx = WithBudget(ctx, 0)
 
-			for k := 0; k < len(task.Result); k++ {
-				postDTO := model.PostDTO{
-					Comments: make([]model.CommentDTO, 0),
-					ID:       task.Result[k].ID,
-					UserID:   task.Result[k].UserID,
-					Title:    task.Result[k].Title,
-					Body:     task.Result[k].Body,
-				}
-				posts = append(posts, postDTO)
-			}
+	for page, pages := 1, 1; page <= pages; page++ {
+		pagedResult, err := r.GetUsers(ctx, page, perPage)
+		if err != nil {
+			return err
 		}
 
-		var comments []model.CommentDTO
+		if err = f(pagedResult.Results); err != nil {
+			return err
+		}
 
-		child := tpl.New().WithMaxGoroutines(1)
-		child.Submit(func() {
-			commentsDTO, err := r.getComments(posts)
-			if err != nil {
-				aggErr = multierr.Append(aggErr, err)
-				return
-			}
+		pages = pagedResult.Pages
+	}
 
-			comments = append(comments, commentsDTO...)
-		})
+	return nil
+}
 
-		child.Wait()
+// aggregate only waits for what finished before deadline, listing the relations
+// left as pending on their users and, when the users come from a listed page,
+// keeping the page's fields of the users left.
+func (r *UsersService) aggregate(ctx context.Context, deadline time.Time, userResponses []model.UserResponse, listed bool) ([]model.UserDTO, error) {
+	users := tpl.NewStageContext(func(ctx context.Context, userResponses []model.UserResponse) ([]model.UserDTO, error) {
+		return r.getUsers(ctx, deadline, userResponses, listed)
+	})
+	posts := tpl.NewStageContext(func(ctx context.Context, userResponses []model.UserResponse) ([]relation[model.PostDTO], error) {
+		return r.getPosts(ctx, deadline, userResponses)
+	})
+	todos := tpl.NewStageContext(func(ctx context.Context, userResponses []model.UserResponse) ([]relation[model.TodoDTO], error) {
+		return r.getTodos(ctx, deadline, userResponses)
+	})
 
-		if aggErr != nil {
-			return
+	return tpl.ZipFailFast(ctx, userResponses, func(err error) ([]model.UserDTO, error) {
+		if err != nil {
+			return nil, err
 		}
 
-		for i := 0; i < len(posts); i++ {
-			post := &posts[i]
-			for k := 0; k < len(comments); k++ {
-				if post.ID == comments[k].PostID {
-					post.Comments = append(post.Comments, comments[k])
-				}
+		usersDTOs := users.Results()
+		userPosts, userTodos := byUser(posts.Results()), byUser(todos.Results())
+
+		var partial bool
+
+		result := make([]model.UserDTO, 0, len(usersDTOs))
+		for i := 0; i < len(usersDTOs); i++ {
+			userDTO := usersDTOs[i]
+
+			postsRelation, todosRelation := userPosts[userDTO.ID], userTodos[userDTO.ID]
+
+			userDTO.Posts = append(make([]model.PostDTO, 0, len(postsRelation.items)), postsRelation.items...)
+			userDTO.Todos = append(make([]model.TodoDTO, 0, len(todosRelation.items)), todosRelation.items...)
+			userDTO.Pending = append(postsRelation.pending, todosRelation.pending...)
+
+			if userDTO.Partial() {
+				partial = true
 			}
 
-			slices.SortFunc(post.Comments, func(a, b model.CommentDTO) int {
-				return cmp.Compare(a.ID, b.ID)
-			})
+			result = append(result, userDTO)
 		}
-	})
 
-	pool.Submit(func() {
-		tpl.ForEach(userResponses, func(userResponse *model.UserResponse) {
-			rChan <- tpl.ToTask[[]model.PostResponse](func() ([]model.PostResponse, error) {
-				return r.userClient.GetPosts(userResponse.ID)
-			})
-		}, runtime.NumCPU()-1)
-	})
+		if partial {
+			partialAggregations.Inc()
+		}
 
-	pool.Wait()
-	close(rChan)
+		slices.SortFunc(result, func(a, b model.UserDTO) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
 
-	return posts, aggErr
+		return result, nil
+	}, users, posts, todos)
 }
 
-func (r *UsersService) getTodos(userResponses []model.UserResponse) ([]model.TodoDTO, error) {
-	var (
-		todos  []model.TodoDTO
-		aggErr error
-	)
-
-	rChan := make(chan tpl.Task[[]model.TodoResponse], len(userResponses))
-
-	pool := tpl.New().WithMaxGoroutines(2)
-	pool.Submit(func() {
-		for i := 0; i < len(userResponses); i++ {
-			task := <-rChan
-			if task.Err != nil {
-				aggErr = multierr.Append(aggErr, task.Err)
-				return
-			}
-			for k := 0; k < len(task.Result); k++ {
-				todoDTO := model.TodoDTO{
-					ID:     task.Result[k].ID,
-					UserID: task.Result[k].UserID,
-					Title:  task.Result[k].Title,
-					DueOn:  task.Result[k].DueOn,
-					Status: task.Result[k].Status,
-				}
-
-				todos = append(todos, todoDTO)
-			}
+// deadline is when the request budget runs out, taken once the aggregation
+// starts, a zero budget meaning no deadline.
+func (r *UsersService) deadline(ctx context.Context) time.Time {
+	budget := r.defaultBudget
+	if requested, found := budgetFrom(ctx); found {
+		budget = min(requested, r.maxBudget)
+	}
+
+	if budget <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(budget)
+}
+
+// relation holds the items of a user, or which relations were still pending
+// when the budget ran out.
+type relation[T any] struct {
+	userID  int
+	items   []T
+	pending []string
+}
+
+func byUser[T any](relations []relation[T]) map[int]relation[T] {
+	result := make(map[int]relation[T], len(relations))
+	for _, rel := range relations {
+		result[rel.userID] = rel
+	}
+
+	return result
+}
+
+func (r *UsersService) getPosts(ctx context.Context, deadline time.Time, userResponses []model.UserResponse) ([]relation[model.PostDTO], error) {
+	budgetCtx, cancel := withDeadline(ctx, deadline)
+	defer cancel()
+
+	collector := tpl.NewCollector[model.PostDTO]()
+
+	err := tpl.ForEachPartial(budgetCtx, userResponses, func(ctx context.Context, userResponse *model.UserResponse) error {
+		source := fmt.Sprintf(postsSource, userResponse.ID)
+
+		postResponses, err := r.userClient.GetPosts(ctx, userResponse.ID)
+		if err != nil {
+			return collector.Fail(source, err)
 		}
-	})
 
-	pool.Submit(func() {
-		tpl.ForEach(userResponses, func(userResponse *model.UserResponse) {
-			rChan <- tpl.ToTask[[]model.TodoResponse](func() ([]model.TodoResponse, error) {
-				return r.userClient.GetTodos(userResponse.ID)
+		posts := make([]model.PostDTO, 0, len(postResponses))
+		for k := 0; k < len(postResponses); k++ {
+			posts = append(posts, model.PostDTO{
+				Comments: make([]model.CommentDTO, 0),
+				ID:       postResponses[k].ID,
+				UserID:   postResponses[k].UserID,
+				Title:    postResponses[k].Title,
+				Body:     postResponses[k].Body,
 			})
-		}, runtime.NumCPU()-1)
-	})
+		}
+		collector.Add(source, posts...)
+
+		return nil
+	}, r.concurrency.Posts)
+	if err != nil {
+		return nil, err
+	}
+
+	collected := collector.BySource()
+
+	userPosts, done := make([][]model.PostDTO, len(userResponses)), make([]bool, len(userResponses))
+	for i := 0; i < len(userResponses); i++ {
+		userPosts[i], done[i] = collected[fmt.Sprintf(postsSource, userResponses[i].ID)]
+	}
+
+	posts := tpl.Flatten(userPosts)
+
+	comments, err := r.getComments(budgetCtx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingComments := make(map[int]bool)
+	for i := 0; i < len(posts); i++ {
+		post := &posts[i]
+		postComments, found := comments[fmt.Sprintf(commentsSource, post.ID)]
+		if !found {
+			pendingComments[post.UserID] = true
+			continue
+		}
 
-	pool.Wait()
-	close(rChan)
+		post.Comments = append(post.Comments, postComments...)
+		slices.SortFunc(post.Comments, func(a, b model.CommentDTO) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+	}
+
+	for i, offset := 0, 0; i < len(userPosts); i++ {
+		userPosts[i], offset = posts[offset:offset+len(userPosts[i])], offset+len(userPosts[i])
+	}
 
-	return todos, aggErr
+	relations := make([]relation[model.PostDTO], len(userResponses))
+	for i := 0; i < len(userResponses); i++ {
+		relations[i] = relation[model.PostDTO]{userID: userResponses[i].ID, items: userPosts[i]}
+		switch {
+		case !done[i]:
+			relations[i].pending = []string{model.RelationPosts}
+		case pendingComments[userResponses[i].ID]:
+			relations[i].pending = []string{model.RelationComments}
+		}
+	}
+
+	return relations, nil
 }
 
-func (r *UsersService) getUsers(userResponses []model.UserResponse) ([]model.UserDTO, error) {
-	var (
-		users  []model.UserDTO
-		aggErr error
-	)
-
-	rChan := make(chan tpl.Task[*model.UserResponse], len(userResponses))
-	pool := tpl.New().WithMaxGoroutines(2)
-
-	pool.Submit(func() {
-		for i := 0; i < len(userResponses); i++ {
-			task := <-rChan
-			if task.Err != nil {
-				aggErr = multierr.Append(aggErr, task.Err)
-				continue
-			}
+func (r *UsersService) getTodos(ctx context.Context, deadline time.Time, userResponses []model.UserResponse) ([]relation[model.TodoDTO], error) {
+	budgetCtx, cancel := withDeadline(ctx, deadline)
+	defer cancel()
 
-			userDTO := &model.UserDTO{
-				ID:     task.Result.ID,
-				Name:   task.Result.Name,
-				Email:  task.Result.Email,
-				Gender: task.Result.Gender,
-				Status: task.Result.Status,
-			}
+	collector := tpl.NewCollector[model.TodoDTO]()
+
+	err := tpl.ForEachPartial(budgetCtx, userResponses, func(ctx context.Context, userResponse *model.UserResponse) error {
+		source := fmt.Sprintf(todosSource, userResponse.ID)
 
-			users = append(users, *userDTO)
+		todoResponses, err := r.userClient.GetTodos(ctx, userResponse.ID)
+		if err != nil {
+			return collector.Fail(source, err)
 		}
-	})
 
-	pool.Submit(func() {
-		tpl.ForEach(userResponses, func(userResponse *model.UserResponse) {
-			rChan <- tpl.ToTask[*model.UserResponse](func() (*model.UserResponse, error) {
-				return r.userClient.GetUser(userResponse.ID)
+		todos := make([]model.TodoDTO, 0, len(todoResponses))
+		for k := 0; k < len(todoResponses); k++ {
+			todos = append(todos, model.TodoDTO{
+				ID:     todoResponses[k].ID,
+				UserID: todoResponses[k].UserID,
+				Title:  todoResponses[k].Title,
+				DueOn:  todoResponses[k].DueOn,
+				Status: todoResponses[k].Status,
 			})
-		}, runtime.NumCPU()-1)
-	})
+		}
+		collector.Add(source, todos...)
+
+		return nil
+	}, r.concurrency.Todos)
+	if err != nil {
+		return nil, err
+	}
 
-	pool.Wait()
-	close(rChan)
+	collected := collector.BySource()
 
-	return users, aggErr
+	relations := make([]relation[model.TodoDTO], len(userResponses))
+	for i := 0; i < len(userResponses); i++ {
+		todos, found := collected[fmt.Sprintf(todosSource, userResponses[i].ID)]
+
+		relations[i] = relation[model.TodoDTO]{userID: userResponses[i].ID, items: todos}
+		if !found {
+			relations[i].pending = []string{model.RelationTodos}
+		}
+	}
+
+	return relations, nil
 }
 
-func (r *UsersService) getComments(posts []model.PostDTO) ([]model.CommentDTO, error) {
-	var (
-		comments []model.CommentDTO
-		aggErr   error
-	)
-
-	rChan := make(chan tpl.Task[[]model.CommentResponse], len(posts))
-
-	pool := tpl.New().WithMaxGoroutines(2)
-	pool.Submit(func() {
-		for i := 0; i < len(posts); i++ {
-			commentTask := <-rChan
-			if commentTask.Err != nil {
-				aggErr = multierr.Append(aggErr, commentTask.Err)
-				return
-			}
-			for k := 0; k < len(commentTask.Result); k++ {
-				commentDTO := &model.CommentDTO{
-					ID:     commentTask.Result[k].ID,
-					PostID: commentTask.Result[k].PostID,
-					Name:   commentTask.Result[k].Name,
-					Email:  commentTask.Result[k].Email,
-					Body:   commentTask.Result[k].Body,
-				}
-				comments = append(comments, *commentDTO)
-			}
+func (r *UsersService) getUsers(ctx context.Context, deadline time.Time, userResponses []model.UserResponse, listed bool) ([]model.UserDTO, error) {
+	budgetCtx, cancel := withDeadline(ctx, deadline)
+	defer cancel()
+
+	collector := tpl.NewCollector[model.UserDTO]()
+
+	err := tpl.ForEachPartial(budgetCtx, userResponses, func(ctx context.Context, userResponse *model.UserResponse) error {
+		source := fmt.Sprintf(userSource, userResponse.ID)
+
+		user, err := r.userClient.GetUser(ctx, userResponse.ID)
+		if err != nil {
+			return collector.Fail(source, err)
 		}
-	})
+		collector.Add(source, newUserDTO(user))
+
+		return nil
+	}, r.concurrency.Users)
+	if err != nil {
+		return nil, err
+	}
 
-	pool.Submit(func() {
-		tpl.ForEach(posts, func(postDTO *model.PostDTO) {
-			rChan <- tpl.ToTask[[]model.CommentResponse](func() ([]model.CommentResponse, error) {
-				return r.userClient.GetComments(postDTO.ID)
+	collected := collector.BySource()
+
+	users := make([]model.UserDTO, 0, len(userResponses))
+	for i := 0; i < len(userResponses); i++ {
+		source := fmt.Sprintf(userSource, userResponses[i].ID)
+		if user, found := collected[source]; found {
+			users = append(users, user...)
+			continue
+		}
+
+		if !listed {
+			return nil, &tpl.SourceError{Source: source, Err: fmt.Errorf("%w: %w", ErrBudgetExceeded, budgetCtx.Err())}
+		}
+
+		users = append(users, newUserDTO(&userResponses[i]))
+	}
+
+	return users, nil
+}
+
+func newUserDTO(user *model.UserResponse) model.UserDTO {
+	return model.UserDTO{
+		ID:     user.ID,
+		Name:   user.Name,
+		Email:  user.Email,
+		Gender: user.Gender,
+		Status: user.Status,
+	}
+}
+
+// getComments returns the comments of every post that answered before ctx was
+// done, by source.
+func (r *UsersService) getComments(ctx context.Context, posts []model.PostDTO) (map[string][]model.CommentDTO, error) {
+	collector := tpl.NewCollector[model.CommentDTO]()
+
+	err := tpl.ForEachPartial(ctx, posts, func(ctx context.Context, postDTO *model.PostDTO) error {
+		source := fmt.Sprintf(commentsSource, postDTO.ID)
+
+		commentResponses, err := r.userClient.GetComments(ctx, postDTO.ID)
+		if err != nil {
+			return collector.Fail(source, err)
+		}
+
+		comments := make([]model.CommentDTO, 0, len(commentResponses))
+		for k := 0; k < len(commentResponses); k++ {
+			comments = append(comments, model.CommentDTO{
+				ID:     commentResponses[k].ID,
+				PostID: commentResponses[k].PostID,
+				Name:   commentResponses[k].Name,
+				Email:  commentResponses[k].Email,
+				Body:   commentResponses[k].Body,
 			})
-		}, runtime.NumCPU()-1)
-	})
+		}
+		collector.Add(source, comments...)
 
-	pool.Wait()
-	close(rChan)
+		return nil
+	}, r.concurrency.Comments)
+	if err != nil {
+		return nil, err
+	}
 
-	return comments, aggErr
+	return collector.BySource(), nil
 }
@@ -1,38 +1,45 @@
 package services_test
 
 import (
+	"context"
 	"errors"
+	"math/rand"
 	"testing"
+	"time"
 
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/services"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
 	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/resources/mocks/src/app/clients"
 )
 
+var concurrency = &services.Concurrency{Users: 2, Posts: 2, Todos: 2, Comments: 2}
+
 func TestService_GetUsers(t *testing.T) {
 	userClient := clients.NewMockIUserClient(t)
 
-	userClient.EXPECT().GetUsers(1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
 		Results: []model.UserResponse{{ID: 1}, {ID: 2}},
 	}, nil)
 
-	userClient.EXPECT().GetUser(1).Return(&model.UserResponse{ID: 1}, nil)
-	userClient.EXPECT().GetPosts(1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil)
-	userClient.EXPECT().GetComments(1).Return([]model.CommentResponse{{ID: 1, PostID: 1, Name: "comment1"}, {ID: 2, PostID: 1, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 1).Return([]model.CommentResponse{{ID: 1, PostID: 1, Name: "comment1"}, {ID: 2, PostID: 1, Name: "comment2"}}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil)
 
-	userClient.EXPECT().GetUser(2).Return(&model.UserResponse{ID: 2}, nil)
-	userClient.EXPECT().GetPosts(2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil)
-	userClient.EXPECT().GetComments(2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(&model.UserResponse{ID: 2}, nil)
+	userClient.EXPECT().GetPosts(mock.Anything, 2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil)
 
-	userService := services.NewUserService(userClient)
+	userService := services.NewUserService(userClient, concurrency)
 
-	pagedResult, err := userService.GetUsers(1, 10)
+	pagedResult, err := userService.GetUsers(context.Background(), 1, 10)
 
 	require.NoError(t, err)
 	assert.NotNil(t, pagedResult)
@@ -52,12 +59,91 @@ func TestService_GetUsers(t *testing.T) {
 	assert.Len(t, pagedResult.Results[1].Todos, 1)
 }
 
+func TestService_GetUsers_Budget(t *testing.T) {
+	userClient := clients.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Results: []model.UserResponse{{ID: 1, Name: "listed1"}, {ID: 2, Name: "listed2"}},
+	}, nil)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, UserID: 1}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 1).Return([]model.CommentResponse{{ID: 1, PostID: 1}}, nil).After(time.Second)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{{ID: 1, UserID: 1}}, nil)
+
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(&model.UserResponse{ID: 2, Name: "user2"}, nil).After(time.Second)
+	userClient.EXPECT().GetPosts(mock.Anything, 2).Return([]model.PostResponse{{ID: 2, UserID: 2}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 2).Return([]model.CommentResponse{{ID: 2, PostID: 2}}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 2).Return([]model.TodoResponse{{ID: 2, UserID: 2}}, nil).After(time.Second)
+
+	ctx := services.WithBudget(context.Background(), 50*time.Millisecond)
+
+	start := time.Now()
+	pagedResult, err := services.NewUserService(userClient, concurrency).GetUsers(ctx, 1, 10)
+
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	require.Len(t, pagedResult.Results, 2)
+
+	assert.Equal(t, []string{model.RelationComments}, pagedResult.Results[0].Pending)
+	require.Len(t, pagedResult.Results[0].Posts, 1)
+	assert.Empty(t, pagedResult.Results[0].Posts[0].Comments)
+	assert.Len(t, pagedResult.Results[0].Todos, 1)
+
+	assert.Equal(t, 2, pagedResult.Results[1].ID)
+	assert.Equal(t, "listed2", pagedResult.Results[1].Name)
+	assert.Equal(t, []string{model.RelationTodos}, pagedResult.Results[1].Pending)
+	require.Len(t, pagedResult.Results[1].Posts, 1)
+	assert.Len(t, pagedResult.Results[1].Posts[0].Comments, 1)
+	assert.NotNil(t, pagedResult.Results[1].Todos)
+	assert.Empty(t, pagedResult.Results[1].Todos)
+}
+
+func TestService_GetUsers_Budget_Page(t *testing.T) {
+	userClient := clients.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Results: []model.UserResponse{{ID: 1, Name: "listed1"}},
+	}, nil).After(100 * time.Millisecond)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "user1"}, nil)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return(nil, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return(nil, nil)
+
+	ctx := services.WithBudget(context.Background(), 50*time.Millisecond)
+
+	actual, err := services.NewUserService(userClient, concurrency).GetUsers(ctx, 1, 10)
+
+	require.NoError(t, err)
+	require.Len(t, actual.Results, 1)
+	assert.Equal(t, "user1", actual.Results[0].Name, "the budget starts once the page is read")
+	assert.Empty(t, actual.Results[0].Pending)
+}
+
+func TestService_GetUser_Budget(t *testing.T) {
+	userClient := clients.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil).After(time.Second)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return(nil, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return(nil, nil)
+
+	ctx := services.WithBudget(context.Background(), 50*time.Millisecond)
+
+	start := time.Now()
+	actual, err := services.NewUserService(userClient, concurrency).GetUser(ctx, 1)
+
+	require.ErrorIs(t, err, services.ErrBudgetExceeded)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Nil(t, actual)
+}
+
 func TestService_GetUsers_Err(t *testing.T) {
 	userClient := clients.NewMockIUserClient(t)
 
-	userClient.EXPECT().GetUsers(1, 10).Return(nil, errors.New("some error"))
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(nil, errors.New("some error"))
 
-	actual, err := services.NewUserService(userClient).GetUsers(1, 10)
+	actual, err := services.NewUserService(userClient, concurrency).GetUsers(context.Background(), 1, 10)
 
 	require.Error(t, err)
 	assert.Nil(t, actual)
@@ -66,21 +152,21 @@ func TestService_GetUsers_Err(t *testing.T) {
 func TestService_GetUsers_Err_UserPool(t *testing.T) {
 	userClient := clients.NewMockIUserClient(t)
 
-	userClient.EXPECT().GetUsers(1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
 		Results: []model.UserResponse{{ID: 1}, {ID: 2}},
 	}, nil)
 
-	userClient.EXPECT().GetUser(1).Return(&model.UserResponse{ID: 1}, nil)
-	userClient.EXPECT().GetPosts(1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil)
-	userClient.EXPECT().GetComments(1).Return([]model.CommentResponse{{ID: 1, PostID: 1, Name: "comment1"}, {ID: 2, PostID: 1, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil).Maybe()
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil).Maybe()
+	userClient.EXPECT().GetComments(mock.Anything, 1).Return([]model.CommentResponse{{ID: 1, PostID: 1, Name: "comment1"}, {ID: 2, PostID: 1, Name: "comment2"}}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil).Maybe()
 
-	userClient.EXPECT().GetUser(2).Return(nil, errors.New("some error"))
-	userClient.EXPECT().GetPosts(2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil)
-	userClient.EXPECT().GetComments(2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(nil, errors.New("some error"))
+	userClient.EXPECT().GetPosts(mock.Anything, 2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil).Maybe()
+	userClient.EXPECT().GetComments(mock.Anything, 2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil).Maybe()
 
-	actual, err := services.NewUserService(userClient).GetUsers(1, 10)
+	actual, err := services.NewUserService(userClient, concurrency).GetUsers(context.Background(), 1, 10)
 
 	require.Error(t, err)
 	assert.Nil(t, actual)
@@ -89,21 +175,21 @@ func TestService_GetUsers_Err_UserPool(t *testing.T) {
 func TestService_GetUsers_Todo_Err(t *testing.T) {
 	userClient := clients.NewMockIUserClient(t)
 
-	userClient.EXPECT().GetUsers(1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
 		Results: []model.UserResponse{{ID: 1}, {ID: 2}},
 	}, nil)
 
-	userClient.EXPECT().GetUser(1).Return(&model.UserResponse{ID: 1}, nil)
-	userClient.EXPECT().GetPosts(1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil)
-	userClient.EXPECT().GetComments(1).Return([]model.CommentResponse{{ID: 1, PostID: 1, Name: "comment1"}, {ID: 2, PostID: 1, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(1).Return(nil, errors.New("some error"))
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil).Maybe()
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil).Maybe()
+	userClient.EXPECT().GetComments(mock.Anything, 1).Return([]model.CommentResponse{{ID: 1, PostID: 1, Name: "comment1"}, {ID: 2, PostID: 1, Name: "comment2"}}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return(nil, errors.New("some error"))
 
-	userClient.EXPECT().GetUser(2).Return(&model.UserResponse{ID: 2}, nil)
-	userClient.EXPECT().GetPosts(2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil)
-	userClient.EXPECT().GetComments(2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(&model.UserResponse{ID: 2}, nil).Maybe()
+	userClient.EXPECT().GetPosts(mock.Anything, 2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil).Maybe()
+	userClient.EXPECT().GetComments(mock.Anything, 2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil).Maybe()
 
-	actual, err := services.NewUserService(userClient).GetUsers(1, 10)
+	actual, err := services.NewUserService(userClient, concurrency).GetUsers(context.Background(), 1, 10)
 
 	require.Error(t, err)
 	assert.Nil(t, actual)
@@ -112,20 +198,20 @@ func TestService_GetUsers_Todo_Err(t *testing.T) {
 func TestService_GetUsers_Post_Err(t *testing.T) {
 	userClient := clients.NewMockIUserClient(t)
 
-	userClient.EXPECT().GetUsers(1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
 		Results: []model.UserResponse{{ID: 1}, {ID: 2}},
 	}, nil)
 
-	userClient.EXPECT().GetUser(1).Return(&model.UserResponse{ID: 1}, nil)
-	userClient.EXPECT().GetPosts(1).Return(nil, errors.New("some error"))
-	userClient.EXPECT().GetTodos(1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil).Maybe()
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return(nil, errors.New("some error"))
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil).Maybe()
 
-	userClient.EXPECT().GetUser(2).Return(&model.UserResponse{ID: 2}, nil)
-	userClient.EXPECT().GetPosts(2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil)
-	userClient.EXPECT().GetComments(2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(&model.UserResponse{ID: 2}, nil).Maybe()
+	userClient.EXPECT().GetPosts(mock.Anything, 2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil).Maybe()
+	userClient.EXPECT().GetComments(mock.Anything, 2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil).Maybe()
 
-	actual, err := services.NewUserService(userClient).GetUsers(1, 10)
+	actual, err := services.NewUserService(userClient, concurrency).GetUsers(context.Background(), 1, 10)
 
 	require.Error(t, err)
 	assert.Nil(t, actual)
@@ -134,22 +220,190 @@ func TestService_GetUsers_Post_Err(t *testing.T) {
 func TestService_GetUsers_Comments_Err(t *testing.T) {
 	userClient := clients.NewMockIUserClient(t)
 
-	userClient.EXPECT().GetUsers(1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
 		Results: []model.UserResponse{{ID: 1}, {ID: 2}},
 	}, nil)
 
-	userClient.EXPECT().GetUser(1).Return(&model.UserResponse{ID: 1}, nil)
-	userClient.EXPECT().GetPosts(1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil)
-	userClient.EXPECT().GetComments(1).Return(nil, errors.New("some error"))
-	userClient.EXPECT().GetTodos(1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil)
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil).Maybe()
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, UserID: 1, Title: "post1"}}, nil).Maybe()
+	userClient.EXPECT().GetComments(mock.Anything, 1).Return(nil, errors.New("some error"))
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Title: "todo1"}}, nil).Maybe()
+
+	userClient.EXPECT().GetUser(mock.Anything, 2).Return(&model.UserResponse{ID: 2}, nil).Maybe()
+	userClient.EXPECT().GetPosts(mock.Anything, 2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil).Maybe()
+	userClient.EXPECT().GetComments(mock.Anything, 2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil).Maybe()
+
+	actual, err := services.NewUserService(userClient, concurrency).GetUsers(context.Background(), 1, 10)
+
+	require.Error(t, err)
+	assert.Nil(t, actual)
+}
+
+func TestService_GetUsers_Panic(t *testing.T) {
+	userClient := clients.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Results: []model.UserResponse{{ID: 1}},
+	}, nil)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(nil, nil)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{}, nil).Maybe()
 
-	userClient.EXPECT().GetUser(2).Return(&model.UserResponse{ID: 2}, nil)
-	userClient.EXPECT().GetPosts(2).Return([]model.PostResponse{{ID: 2, UserID: 2, Title: "post2"}}, nil)
-	userClient.EXPECT().GetComments(2).Return([]model.CommentResponse{{ID: 3, PostID: 2, Name: "comment2"}}, nil)
-	userClient.EXPECT().GetTodos(2).Return([]model.TodoResponse{{ID: 2, UserID: 2, Title: "todo2"}}, nil)
+	actual, err := services.NewUserService(userClient, concurrency).GetUsers(context.Background(), 1, 10)
 
-	actual, err := services.NewUserService(userClient).GetUsers(1, 10)
+	var panicErr *tpl.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Contains(t, panicErr.Task, "getUsers")
+	assert.Nil(t, actual)
+}
+
+func TestService_GetUserStats(t *testing.T) {
+	userClient := clients.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1, Name: "John"}, nil)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 1).Return([]model.CommentResponse{{ID: 1, PostID: 1}, {ID: 2, PostID: 1}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 2).Return([]model.CommentResponse{{ID: 3, PostID: 2}}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{
+		{ID: 1, UserID: 1, Status: "pending", DueOn: time.Now().Add(-time.Hour)},
+		{ID: 2, UserID: 1, Status: "pending", DueOn: time.Now().Add(time.Hour)},
+		{ID: 3, UserID: 1, Status: "completed", DueOn: time.Now().Add(-time.Hour)},
+	}, nil)
+
+	actual, err := services.NewUserService(userClient, concurrency).GetUserStats(context.Background(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "John", actual.Name)
+	assert.Equal(t, 2, actual.Stats.Posts)
+	assert.Equal(t, 3, actual.Stats.CommentsReceived)
+	assert.Equal(t, model.TodoStatsDTO{Total: 3, Pending: 2, Completed: 1, Overdue: 1}, actual.Stats.Todos)
+}
+
+func TestService_GetUserStats_Err(t *testing.T) {
+	userClient := clients.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(nil, errors.New("some error"))
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{}, nil).Maybe()
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{}, nil).Maybe()
+
+	actual, err := services.NewUserService(userClient, concurrency).GetUserStats(context.Background(), 1)
 
 	require.Error(t, err)
 	assert.Nil(t, actual)
 }
+
+func TestService_GetUsersStats(t *testing.T) {
+	userClient := clients.NewMockIUserClient(t)
+
+	userClient.EXPECT().GetUsers(mock.Anything, 1, 10).Return(&paging.PagedResultResponse[model.UserResponse]{
+		Page:    1,
+		Pages:   1,
+		Total:   1,
+		Results: []model.UserResponse{{ID: 1}},
+	}, nil)
+
+	userClient.EXPECT().GetUser(mock.Anything, 1).Return(&model.UserResponse{ID: 1}, nil)
+	userClient.EXPECT().GetPosts(mock.Anything, 1).Return([]model.PostResponse{{ID: 1, UserID: 1}}, nil)
+	userClient.EXPECT().GetComments(mock.Anything, 1).Return([]model.CommentResponse{{ID: 1, PostID: 1}}, nil)
+	userClient.EXPECT().GetTodos(mock.Anything, 1).Return([]model.TodoResponse{{ID: 1, UserID: 1, Status: "completed"}}, nil)
+
+	actual, err := services.NewUserService(userClient, concurrency).GetUsersStats(context.Background(), 1, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, actual.Total)
+	require.Len(t, actual.Results, 1)
+	assert.Equal(t, 1, actual.Results[0].Stats.Posts)
+	assert.Equal(t, 1, actual.Results[0].Stats.CommentsReceived)
+	assert.Equal(t, 1, actual.Results[0].Stats.Todos.Completed)
+}
+
+func TestService_GetUsers_Stress(t *testing.T) {
+	const users = 20
+
+	errInjected := errors.New("injected error")
+
+	for _, failureRate := range []int{0, 2, 50} {
+		failureRate := failureRate
+		for run := 0; run < 10; run++ {
+			userClient := clients.NewMockIUserClient(t)
+
+			userResponses := make([]model.UserResponse, users)
+			for i := 0; i < users; i++ {
+				userResponses[i] = model.UserResponse{ID: i + 1}
+			}
+
+			userClient.EXPECT().GetUsers(mock.Anything, 1, users).Return(&paging.PagedResultResponse[model.UserResponse]{
+				Results: userResponses,
+			}, nil)
+
+			call := func() error {
+				time.Sleep(time.Duration(rand.Intn(500)) * time.Microsecond)
+				if rand.Intn(100) < failureRate {
+					return errInjected
+				}
+				return nil
+			}
+
+			userClient.EXPECT().GetUser(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, userID int) (*model.UserResponse, error) {
+				if err := call(); err != nil {
+					return nil, err
+				}
+				return &model.UserResponse{ID: userID}, nil
+			}).Maybe()
+			userClient.EXPECT().GetPosts(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, userID int) ([]model.PostResponse, error) {
+				if err := call(); err != nil {
+					return nil, err
+				}
+				return []model.PostResponse{{ID: userID * 10, UserID: userID}, {ID: userID*10 + 1, UserID: userID}}, nil
+			}).Maybe()
+			userClient.EXPECT().GetComments(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, postID int) ([]model.CommentResponse, error) {
+				if err := call(); err != nil {
+					return nil, err
+				}
+				return []model.CommentResponse{{ID: postID*10 + 2, PostID: postID}, {ID: postID * 10, PostID: postID}, {ID: postID*10 + 1, PostID: postID}}, nil
+			}).Maybe()
+			userClient.EXPECT().GetTodos(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, userID int) ([]model.TodoResponse, error) {
+				if err := call(); err != nil {
+					return nil, err
+				}
+				return []model.TodoResponse{{ID: userID, UserID: userID}}, nil
+			}).Maybe()
+
+			actual, err := services.NewUserService(userClient, concurrency).GetUsers(context.Background(), 1, users)
+
+			if err != nil {
+				require.NotZero(t, failureRate, err)
+
+				var sourceErr *tpl.SourceError
+				require.ErrorAs(t, err, &sourceErr)
+				require.ErrorIs(t, err, errInjected)
+				assert.Nil(t, actual)
+				continue
+			}
+
+			require.Len(t, actual.Results, users)
+			for i, user := range actual.Results {
+				require.Equal(t, i+1, user.ID)
+				require.Len(t, user.Todos, 1)
+				require.Len(t, user.Posts, 2)
+				for _, post := range user.Posts {
+					require.Equal(t, user.ID, post.UserID)
+					require.Len(t, post.Comments, 3)
+					for k, comment := range post.Comments {
+						require.Equal(t, post.ID*10+k, comment.ID)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestConcurrency_Validate(t *testing.T) {
+	assert.NoError(t, concurrency.Validate())
+
+	err := (&services.Concurrency{Users: 1, Posts: 0, Todos: -1, Comments: 1}).Validate()
+
+	require.EqualError(t, err, "concurrency.posts must be at least 1, got 0; concurrency.todos must be at least 1, got -1")
+}
@@ -0,0 +1,92 @@
+package tpl
+
+import (
+	"sync"
+
+	"go.uber.org/multierr"
+)
+
+// Collector gathers results and errors from concurrent tasks, labelling both
+// with the source that produced them.
+type Collector[T any] struct {
+	mtx     sync.Mutex
+	sources []string
+	results map[string][]T
+	errs    []error
+}
+
+func NewCollector[T any]() *Collector[T] {
+	return &Collector[T]{
+		results: make(map[string][]T),
+	}
+}
+
+func (r *Collector[T]) Add(source string, results ...T) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if _, ok := r.results[source]; !ok {
+		r.sources = append(r.sources, source)
+	}
+
+	r.results[source] = append(r.results[source], results...)
+}
+
+func (r *Collector[T]) Fail(source string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	sourceErr := &SourceError{Source: source, Err: err}
+
+	r.mtx.Lock()
+	r.errs = append(r.errs, sourceErr)
+	r.mtx.Unlock()
+
+	return sourceErr
+}
+
+// Collect records either the results or the error of a task, returning the
+// labelled error so it can be handed back to a pool.
+func (r *Collector[T]) Collect(source string, results []T, err error) error {
+	if err != nil {
+		return r.Fail(source, err)
+	}
+
+	r.Add(source, results...)
+
+	return nil
+}
+
+// Results returns every collected result, grouped by source in the order the
+// sources first reported.
+func (r *Collector[T]) Results() []T {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var results []T
+	for _, source := range r.sources {
+		results = append(results, r.results[source]...)
+	}
+
+	return results
+}
+
+func (r *Collector[T]) BySource() map[string][]T {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	results := make(map[string][]T, len(r.results))
+	for source, values := range r.results {
+		results[source] = append([]T(nil), values...)
+	}
+
+	return results
+}
+
+func (r *Collector[T]) Err() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return multierr.Combine(r.errs...)
+}
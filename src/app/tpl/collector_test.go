@@ -0,0 +1,78 @@
+package tpl_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+	"go.uber.org/multierr"
+)
+
+func TestCollector(t *testing.T) {
+	collector := tpl.NewCollector[int]()
+
+	assert.NoError(t, collector.Collect("a", []int{1, 2}, nil))
+	collector.Add("b", 3)
+	collector.Add("a", 4)
+
+	err := collector.Collect("c", nil, errTask)
+
+	var sourceErr *tpl.SourceError
+	require.ErrorAs(t, err, &sourceErr)
+	assert.Equal(t, "c", sourceErr.Source)
+	assert.ErrorIs(t, err, errTask)
+	assert.EqualError(t, err, "c: task error")
+
+	assert.Equal(t, []int{1, 2, 4, 3}, collector.Results())
+	assert.Equal(t, map[string][]int{"a": {1, 2, 4}, "b": {3}}, collector.BySource())
+	assert.ErrorIs(t, collector.Err(), errTask)
+	assert.Nil(t, collector.Fail("d", nil))
+}
+
+func TestCollector_Stress(t *testing.T) {
+	const tasks = 200
+
+	for run := 0; run < 20; run++ {
+		collector := tpl.NewCollector[int]()
+		failed := make([]bool, tasks)
+
+		for i := 0; i < tasks; i++ {
+			failed[i] = rand.Intn(10) == 0
+		}
+
+		pool := tpl.New().WithMaxGoroutines(16)
+		for i := 0; i < tasks; i++ {
+			i := i
+			pool.Submit(func() {
+				time.Sleep(time.Duration(rand.Intn(200)) * time.Microsecond)
+
+				var err error
+				if failed[i] {
+					err = errors.New("injected")
+				}
+
+				_ = collector.Collect(fmt.Sprintf("task/%d", i), []int{i, i}, err)
+				_ = collector.Results()
+			})
+		}
+		pool.Wait()
+
+		var expectedErrs, expectedResults int
+		for i := 0; i < tasks; i++ {
+			if failed[i] {
+				expectedErrs++
+			} else {
+				expectedResults += 2
+			}
+		}
+
+		assert.Len(t, collector.Results(), expectedResults)
+		assert.Len(t, collector.BySource(), tasks-expectedErrs)
+		assert.Len(t, multierr.Errors(collector.Err()), expectedErrs)
+	}
+}
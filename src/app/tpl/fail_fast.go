@@ -0,0 +1,171 @@
+package tpl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/multierr"
+)
+
+// FailFastError carries the error that cancelled a fail-fast run along with
+// any other errors returned by tasks that were already in flight.
+type FailFastError struct {
+	Err    error
+	Others []error
+}
+
+func (e *FailFastError) Error() string {
+	messages := []string{e.Err.Error()}
+	for _, err := range e.Others {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e *FailFastError) Unwrap() []error {
+	return append([]error{e.Err}, e.Others...)
+}
+
+type group struct {
+	parent   context.Context
+	ctx      context.Context
+	cancel   context.CancelCauseFunc
+	failFast bool
+
+	mtx    sync.Mutex
+	first  error
+	others []error
+}
+
+func newGroup(ctx context.Context, failFast bool) *group {
+	groupCtx, cancel := context.WithCancelCause(ctx)
+
+	return &group{
+		parent:   ctx,
+		ctx:      groupCtx,
+		cancel:   cancel,
+		failFast: failFast,
+	}
+}
+
+func (r *group) stopped() bool {
+	return r.failFast && r.ctx.Err() != nil
+}
+
+func (r *group) add(err error) {
+	if err == nil {
+		return
+	}
+
+	var failFastErr *FailFastError
+	if errors.As(err, &failFastErr) {
+		r.add(failFastErr.Err)
+		for _, other := range failFastErr.Others {
+			r.add(other)
+		}
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	switch {
+	case !r.failFast:
+		r.others = append(r.others, err)
+	case r.first == nil:
+		r.first = err
+		r.cancel(err)
+	case !errors.Is(err, context.Canceled):
+		r.others = append(r.others, err)
+	}
+}
+
+func (r *group) wait() error {
+	defer r.cancel(nil)
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if !r.failFast {
+		return multierr.Combine(append(r.others, r.parent.Err())...)
+	}
+
+	if r.first == nil {
+		return r.parent.Err()
+	}
+
+	return &FailFastError{
+		Err:    r.first,
+		Others: r.others,
+	}
+}
+
+// FailFastPool cancels its context on the first task error and stops running
+// the tasks that have not started yet.
+type FailFastPool struct {
+	pool  *pool.Pool
+	group *group
+}
+
+func (r *Pool) WithFailFast(ctx context.Context) *FailFastPool {
+	return &FailFastPool{
+		pool:  r.Pool,
+		group: newGroup(ctx, true),
+	}
+}
+
+func (r *FailFastPool) Context() context.Context {
+	return r.group.ctx
+}
+
+func (r *FailFastPool) Submit(task func(ctx context.Context) error) {
+	r.submit(taskName(task), task)
+}
+
+func (r *FailFastPool) submit(name string, task func(ctx context.Context) error) {
+	if r.group.stopped() {
+		return
+	}
+
+	r.pool.Go(func() {
+		if r.group.stopped() {
+			return
+		}
+		r.group.add(recoverTask(name, func() error {
+			return task(r.group.ctx)
+		}))
+	})
+}
+
+func (r *FailFastPool) Wait() error {
+	r.pool.Wait()
+	return r.group.wait()
+}
+
+func ForEachFailFast[T any](ctx context.Context, input []T, f func(ctx context.Context, element *T) error, maxGoroutines ...int) error {
+	p := New().WithMaxGoroutines(goroutines(maxGoroutines)).WithFailFast(ctx)
+	name := taskName(f)
+
+	for i := 0; i < len(input) && !p.group.stopped(); i++ {
+		element := &input[i]
+		p.submit(name, func(ctx context.Context) error {
+			return f(ctx, element)
+		})
+	}
+
+	return p.Wait()
+}
+
+// ForEachPartial is ForEachFailFast that stops waiting once ctx is done, tasks
+// still running being left to complete in the background, so only errors other
+// than ctx's own fail the call. The tasks report their own results, typically
+// to a Collector.
+func ForEachPartial[T any](ctx context.Context, input []T, f func(ctx context.Context, element *T) error, maxGoroutines ...int) error {
+	return partial(ctx, func() error {
+		return ForEachFailFast(ctx, input, f, maxGoroutines...)
+	})
+}
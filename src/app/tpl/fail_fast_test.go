@@ -0,0 +1,177 @@
+package tpl_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+)
+
+var errTask = errors.New("task error")
+
+func TestPool_WithFailFast(t *testing.T) {
+	pool := tpl.New().WithMaxGoroutines(3).WithFailFast(context.Background())
+
+	pool.Submit(func(ctx context.Context) error {
+		return errTask
+	})
+
+	for i := 0; i < 2; i++ {
+		pool.Submit(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}
+
+	<-pool.Context().Done()
+
+	var executed atomic.Bool
+	pool.Submit(func(ctx context.Context) error {
+		executed.Store(true)
+		return nil
+	})
+
+	err := pool.Wait()
+
+	var failFastErr *tpl.FailFastError
+	require.ErrorAs(t, err, &failFastErr)
+	assert.Equal(t, errTask, failFastErr.Err)
+	assert.Empty(t, failFastErr.Others)
+	assert.False(t, executed.Load())
+}
+
+func TestForEachFailFast(t *testing.T) {
+	numbers := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	var executed atomic.Int32
+
+	err := tpl.ForEachFailFast(context.Background(), numbers, func(ctx context.Context, i *int) error {
+		executed.Add(1)
+		if *i == 3 {
+			return errTask
+		}
+		return nil
+	}, 1)
+
+	require.ErrorIs(t, err, errTask)
+	assert.Equal(t, int32(4), executed.Load())
+}
+
+func TestForEachFailFast_Others(t *testing.T) {
+	otherErr := errors.New("other error")
+	started := make(chan struct{}, 2)
+
+	err := tpl.ForEachFailFast(context.Background(), []int{0, 1}, func(ctx context.Context, i *int) error {
+		started <- struct{}{}
+		for len(started) < 2 {
+			time.Sleep(time.Millisecond)
+		}
+
+		if *i == 0 {
+			return errTask
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		return otherErr
+	}, 2)
+
+	var failFastErr *tpl.FailFastError
+	require.ErrorAs(t, err, &failFastErr)
+	assert.Equal(t, errTask, failFastErr.Err)
+	assert.Equal(t, []error{otherErr}, failFastErr.Others)
+	assert.ErrorIs(t, err, otherErr)
+	assert.EqualError(t, err, "task error; other error")
+}
+
+func TestForEachFailFast_ParentCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var executed atomic.Int32
+
+	err := tpl.ForEachFailFast(ctx, []int{0, 1, 2}, func(ctx context.Context, i *int) error {
+		executed.Add(1)
+		return nil
+	})
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, executed.Load())
+}
+
+func TestForEachPartial(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	collector := tpl.NewCollector[int]()
+
+	start := time.Now()
+	err := tpl.ForEachPartial(ctx, []int{0, 1, 2, 3}, func(ctx context.Context, i *int) error {
+		if *i%2 == 1 {
+			time.Sleep(time.Second)
+		}
+		collector.Add(strconv.Itoa(*i), *i*2)
+		return nil
+	}, 4)
+
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, map[string][]int{"0": {0}, "2": {4}}, collector.BySource())
+}
+
+func TestForEachPartial_Err(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	collector := tpl.NewCollector[int]()
+
+	err := tpl.ForEachPartial(ctx, []int{0, 1, 2, 3}, func(ctx context.Context, i *int) error {
+		if *i == 1 {
+			return collector.Fail("1", errTask)
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}, 4)
+
+	var sourceErr *tpl.SourceError
+	require.ErrorAs(t, err, &sourceErr)
+	assert.Equal(t, "1", sourceErr.Source)
+	assert.ErrorIs(t, collector.Err(), errTask)
+}
+
+func TestZipFailFast(t *testing.T) {
+	started := make(chan struct{}, 2)
+
+	failing := tpl.NewStage(func(elements []int) ([]int, error) {
+		<-started
+		return nil, errTask
+	})
+
+	var canceled atomic.Bool
+	waiting := tpl.NewStageContext(func(ctx context.Context, elements []int) ([]string, error) {
+		started <- struct{}{}
+		select {
+		case <-ctx.Done():
+			canceled.Store(true)
+			return nil, ctx.Err()
+		case <-time.After(5 * time.Second):
+			return []string{"late"}, nil
+		}
+	}, 2)
+
+	actual, err := tpl.ZipFailFast(context.Background(), []int{1, 2, 3}, func(err error) ([]string, error) {
+		return waiting.Results(), err
+	}, failing, waiting)
+
+	var failFastErr *tpl.FailFastError
+	require.ErrorAs(t, err, &failFastErr)
+	assert.Equal(t, errTask, failFastErr.Err)
+	assert.Empty(t, failFastErr.Others)
+	assert.True(t, canceled.Load())
+	assert.Nil(t, actual)
+}
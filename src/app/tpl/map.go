@@ -0,0 +1,173 @@
+package tpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/sourcegraph/conc/pool"
+)
+
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("item %d: %s", e.Index, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// Map runs f over input with at most maxGoroutines at once, returning the
+// results in input order. It only fails when ctx is done or f panics.
+func Map[T any, R any](ctx context.Context, input []T, f func(ctx context.Context, element *T) R, maxGoroutines ...int) ([]R, error) {
+	return mapErr(ctx, false, taskName(f), input, func(ctx context.Context, element *T) (R, error) {
+		return f(ctx, element), nil
+	}, maxGoroutines...)
+}
+
+// MapErr is Map for fallible functions. Every item runs and the results are
+// returned along with the errors of the failed items, as *ItemError, whose
+// results are left zero.
+func MapErr[T any, R any](ctx context.Context, input []T, f func(ctx context.Context, element *T) (R, error), maxGoroutines ...int) ([]R, error) {
+	return mapErr(ctx, false, taskName(f), input, f, maxGoroutines...)
+}
+
+// MapErrFailFast is MapErr cancelling ctx and skipping the pending items on the
+// first error, which is returned within a *FailFastError.
+func MapErrFailFast[T any, R any](ctx context.Context, input []T, f func(ctx context.Context, element *T) (R, error), maxGoroutines ...int) ([]R, error) {
+	return mapErr(ctx, true, taskName(f), input, f, maxGoroutines...)
+}
+
+// MapErrPartial is MapErrFailFast that stops waiting once ctx is done, returning
+// the results finished by then and which items they belong to. Items still
+// running are left to complete in the background and their results dropped, so
+// only errors other than ctx's own fail the call.
+func MapErrPartial[T any, R any](ctx context.Context, input []T, f func(ctx context.Context, element *T) (R, error), maxGoroutines ...int) ([]R, []bool, error) {
+	var (
+		mtx     sync.Mutex
+		closed  bool
+		results = make([]R, len(input))
+		done    = make([]bool, len(input))
+	)
+
+	indexes := make([]int, len(input))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	err := partial(ctx, func() error {
+		_, err := mapErr(ctx, true, taskName(f), indexes, func(ctx context.Context, i *int) (struct{}, error) {
+			result, err := f(ctx, &input[*i])
+			if err != nil {
+				return struct{}{}, err
+			}
+
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			if !closed {
+				results[*i], done[*i] = result, true
+			}
+
+			return struct{}{}, nil
+		}, maxGoroutines...)
+		return err
+	})
+
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	closed = true
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, done, nil
+}
+
+// partial runs run until it returns or ctx is done, leaving it to complete in
+// the background in the latter case. Only errors other than ctx's own fail.
+func partial(ctx context.Context, run func() error) error {
+	finished := make(chan error, 1)
+	go func() {
+		finished <- run()
+	}()
+
+	var err error
+	select {
+	case err = <-finished:
+	case <-ctx.Done():
+	}
+
+	if err != nil && !interrupted(ctx, err) {
+		return err
+	}
+
+	return nil
+}
+
+// interrupted tells whether err is ctx ending rather than a task failing.
+func interrupted(ctx context.Context, err error) bool {
+	var failFastErr *FailFastError
+	if errors.As(err, &failFastErr) {
+		err = failFastErr.Err
+	}
+
+	return ctx.Err() != nil && errors.Is(err, ctx.Err())
+}
+
+func mapErr[T any, R any](ctx context.Context, failFast bool, name string, input []T, f func(ctx context.Context, element *T) (R, error), maxGoroutines ...int) ([]R, error) {
+	results := make([]R, len(input))
+	g := newGroup(ctx, failFast)
+
+	p := pool.New().WithMaxGoroutines(goroutines(maxGoroutines))
+	for i := 0; i < len(input) && g.ctx.Err() == nil; i++ {
+		i := i
+		p.Go(func() {
+			if g.ctx.Err() != nil {
+				return
+			}
+
+			err := recoverTask(name, func() error {
+				result, err := f(g.ctx, &input[i])
+				if err == nil {
+					results[i] = result
+				}
+				return err
+			})
+			if err != nil {
+				g.add(&ItemError{Index: i, Err: err})
+			}
+		})
+	}
+	p.Wait()
+
+	if err := g.wait(); err != nil {
+		if failFast {
+			return nil, err
+		}
+		return results, err
+	}
+
+	return results, nil
+}
+
+func Flatten[T any](input [][]T) []T {
+	var size int
+	for _, elements := range input {
+		size += len(elements)
+	}
+
+	result := make([]T, 0, size)
+	for _, elements := range input {
+		result = append(result, elements...)
+	}
+
+	return result
+}
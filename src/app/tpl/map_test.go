@@ -0,0 +1,162 @@
+package tpl_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+	"go.uber.org/multierr"
+)
+
+func TestMap(t *testing.T) {
+	numbers := make([]int, 100)
+	for i := range numbers {
+		numbers[i] = i
+	}
+
+	var running, peak atomic.Int32
+
+	actual, err := tpl.Map(context.Background(), numbers, func(ctx context.Context, i *int) int {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if current <= p || peak.CompareAndSwap(p, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Duration(rand.Intn(300)) * time.Microsecond)
+		return *i * 2
+	}, 4)
+
+	require.NoError(t, err)
+	require.Len(t, actual, len(numbers))
+	for i, result := range actual {
+		assert.Equal(t, i*2, result)
+	}
+	assert.LessOrEqual(t, peak.Load(), int32(4))
+}
+
+func TestMapErr(t *testing.T) {
+	actual, err := tpl.MapErr(context.Background(), []int{0, 1, 2, 3}, func(ctx context.Context, i *int) (string, error) {
+		if *i%2 == 1 {
+			return "failed", errTask
+		}
+		return "ok", nil
+	}, 2)
+
+	assert.Equal(t, []string{"ok", "", "ok", ""}, actual)
+	require.ErrorIs(t, err, errTask)
+
+	errs := multierr.Errors(err)
+	require.Len(t, errs, 2)
+
+	var indexes []int
+	for _, e := range errs {
+		var itemErr *tpl.ItemError
+		require.ErrorAs(t, e, &itemErr)
+		indexes = append(indexes, itemErr.Index)
+	}
+	assert.ElementsMatch(t, []int{1, 3}, indexes)
+}
+
+func TestMapErrFailFast(t *testing.T) {
+	var executed atomic.Int32
+
+	_, err := tpl.MapErrFailFast(context.Background(), []int{0, 1, 2, 3, 4}, func(ctx context.Context, i *int) (int, error) {
+		executed.Add(1)
+		if *i == 1 {
+			return 0, errTask
+		}
+		return *i, nil
+	}, 1)
+
+	var failFastErr *tpl.FailFastError
+	require.ErrorAs(t, err, &failFastErr)
+
+	var itemErr *tpl.ItemError
+	require.ErrorAs(t, failFastErr.Err, &itemErr)
+	assert.Equal(t, 1, itemErr.Index)
+	assert.Equal(t, int32(2), executed.Load())
+}
+
+func TestMap_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := tpl.Map(ctx, []int{0, 1, 2, 3}, func(ctx context.Context, i *int) int {
+		if *i == 0 {
+			cancel()
+		}
+		return *i
+	}, 1)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMap_Panic(t *testing.T) {
+	_, err := tpl.Map(context.Background(), []int{0}, func(ctx context.Context, i *int) int {
+		panic(errors.New("boom"))
+	})
+
+	var panicErr *tpl.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Contains(t, panicErr.Task, "TestMap_Panic")
+	assert.EqualError(t, errors.Unwrap(panicErr), "boom")
+}
+
+func TestFlatten(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, tpl.Flatten([][]int{{1}, nil, {2, 3}}))
+	assert.Empty(t, tpl.Flatten[int](nil))
+}
+
+func TestMapErrPartial(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	actual, done, err := tpl.MapErrPartial(ctx, []int{0, 1, 2, 3}, func(ctx context.Context, i *int) (int, error) {
+		if *i%2 == 1 {
+			time.Sleep(time.Second)
+		}
+		return *i * 2, nil
+	}, 4)
+
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, []int{0, 0, 4, 0}, actual)
+	assert.Equal(t, []bool{true, false, true, false}, done)
+}
+
+func TestMapErrPartial_Done(t *testing.T) {
+	actual, done, err := tpl.MapErrPartial(context.Background(), []int{1, 2, 3}, func(ctx context.Context, i *int) (int, error) {
+		return *i * 2, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 6}, actual)
+	assert.Equal(t, []bool{true, true, true}, done)
+}
+
+func TestMapErrPartial_Err(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	actual, done, err := tpl.MapErrPartial(ctx, []int{0, 1, 2, 3}, func(ctx context.Context, i *int) (int, error) {
+		if *i == 1 {
+			return 0, errTask
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}, 4)
+
+	require.ErrorIs(t, err, errTask)
+	assert.Nil(t, actual)
+	assert.Nil(t, done)
+}
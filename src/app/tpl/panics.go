@@ -0,0 +1,61 @@
+package tpl
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var taskPanics = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "gorest_api",
+	Subsystem: "tpl",
+	Name:      "task_panics_total",
+	Help:      "Number of panics recovered from pool tasks, by task.",
+}, []string{"task"})
+
+// PanicError is a panic recovered from a task, surfaced through the error path
+// of the pool that ran it.
+type PanicError struct {
+	Task  string
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in task %s: %v", e.Task, e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func recoverTask(task string, f func() error) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			taskPanics.WithLabelValues(task).Inc()
+			err = &PanicError{
+				Task:  task,
+				Value: value,
+				Stack: debug.Stack(),
+			}
+		}
+	}()
+
+	return f()
+}
+
+func taskName(f any) string {
+	if fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer()); fn != nil {
+		return fn.Name()
+	}
+
+	return "unknown"
+}
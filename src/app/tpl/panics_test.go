@@ -0,0 +1,98 @@
+package tpl_test
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+)
+
+func TestPool_Submit_Panic(t *testing.T) {
+	before := taskPanics(t)
+
+	pool := tpl.New().WithMaxGoroutines(2)
+	pool.Submit(func() {
+		panic("boom")
+	})
+	pool.Submit(func() {})
+
+	err := pool.Wait()
+
+	var panicErr *tpl.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "boom", panicErr.Value)
+	assert.Contains(t, panicErr.Task, "TestPool_Submit_Panic")
+	assert.Contains(t, string(panicErr.Stack), "panics_test.go")
+	assert.Equal(t, before+1, taskPanics(t))
+}
+
+func TestForEach_Panic(t *testing.T) {
+	var users []*struct{ ID int }
+	users = append(users, &struct{ ID int }{ID: 1}, nil)
+
+	var ids []int
+	err := tpl.ForEach(users, func(user **struct{ ID int }) {
+		ids = append(ids, (*user).ID)
+	}, 1)
+
+	var panicErr *tpl.PanicError
+	require.ErrorAs(t, err, &panicErr)
+
+	var runtimeErr runtime.Error
+	assert.ErrorAs(t, err, &runtimeErr)
+	assert.True(t, strings.HasPrefix(err.Error(), "panic in task "))
+	assert.Equal(t, []int{1}, ids)
+}
+
+func TestForEachFailFast_Panic(t *testing.T) {
+	err := tpl.ForEachFailFast(context.Background(), []int{0, 1, 2}, func(ctx context.Context, i *int) error {
+		if *i == 0 {
+			panic("boom")
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}, 3)
+
+	var failFastErr *tpl.FailFastError
+	require.ErrorAs(t, err, &failFastErr)
+
+	var panicErr *tpl.PanicError
+	require.ErrorAs(t, failFastErr.Err, &panicErr)
+	assert.Empty(t, failFastErr.Others)
+}
+
+func TestZip_Panic(t *testing.T) {
+	stage := tpl.NewStage(func(elements []int) ([]int, error) {
+		panic("boom")
+	}, 2)
+
+	_, err := tpl.Zip([]int{1, 2}, func(err error) ([]int, error) {
+		return nil, nil
+	}, stage)
+
+	var panicErr *tpl.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Contains(t, panicErr.Task, "TestZip_Panic")
+}
+
+func taskPanics(t *testing.T) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	var total float64
+	for _, family := range families {
+		if family.GetName() != "gorest_api_tpl_task_panics_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			total += metric.GetCounter().GetValue()
+		}
+	}
+
+	return total
+}
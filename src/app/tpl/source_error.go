@@ -0,0 +1,19 @@
+package tpl
+
+import (
+	"fmt"
+)
+
+// SourceError labels the error of a task with the source it failed on.
+type SourceError struct {
+	Source string
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Source, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
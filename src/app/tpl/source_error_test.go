@@ -0,0 +1,19 @@
+package tpl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+)
+
+func TestSourceError(t *testing.T) {
+	var err error = &tpl.SourceError{Source: "c", Err: errTask}
+
+	var sourceErr *tpl.SourceError
+	require.ErrorAs(t, err, &sourceErr)
+	assert.Equal(t, "c", sourceErr.Source)
+	assert.ErrorIs(t, err, errTask)
+	assert.EqualError(t, err, "c: task error")
+}
@@ -2,15 +2,18 @@ package tpl
 
 import (
 	"runtime"
-
-	"go.uber.org/multierr"
+	"sync"
 
 	"github.com/sourcegraph/conc/iter"
 	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/multierr"
 )
 
 type Pool struct {
 	*pool.Pool
+
+	mtx  sync.Mutex
+	errs []error
 }
 
 func New() *Pool {
@@ -25,7 +28,29 @@ func (r *Pool) WithMaxGoroutines(maxGoroutines int) *Pool {
 }
 
 func (r *Pool) Submit(task func()) {
-	r.Pool.Go(task)
+	name := taskName(task)
+
+	r.Pool.Go(func() {
+		err := recoverTask(name, func() error {
+			task()
+			return nil
+		})
+		if err != nil {
+			r.mtx.Lock()
+			r.errs = append(r.errs, err)
+			r.mtx.Unlock()
+		}
+	})
+}
+
+// Wait returns the panics recovered from the submitted tasks as *PanicError.
+func (r *Pool) Wait() error {
+	r.Pool.Wait()
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return multierr.Combine(r.errs...)
 }
 
 type Task[T any] struct {
@@ -42,26 +67,45 @@ func ToTask[T any](f func() (T, error)) Task[T] {
 	}
 }
 
-func ForEach[T any](input []T, f func(*T), maxGoroutines ...int) {
+// ForEach returns the panics recovered from f as *PanicError.
+func ForEach[T any](input []T, f func(*T), maxGoroutines ...int) error {
 	it := iter.Iterator[T]{
-		MaxGoroutines: runtime.NumCPU() - 1,
+		MaxGoroutines: goroutines(maxGoroutines),
 	}
 
+	var (
+		name = taskName(f)
+		mtx  sync.Mutex
+		errs []error
+	)
+
+	it.ForEach(input, func(element *T) {
+		err := recoverTask(name, func() error {
+			f(element)
+			return nil
+		})
+		if err != nil {
+			mtx.Lock()
+			errs = append(errs, err)
+			mtx.Unlock()
+		}
+	})
+
+	return multierr.Combine(errs...)
+}
+
+func goroutines(maxGoroutines []int) int {
 	if len(maxGoroutines) > 0 && maxGoroutines[0] > 0 {
-		it.MaxGoroutines = maxGoroutines[0]
+		return maxGoroutines[0]
 	}
 
-	it.ForEach(input, f)
+	return max(runtime.NumCPU()-1, 1)
 }
 
-type Pool41[TInput any, T1 any, T2 any, T3 any] struct {
-	*pool.Pool
-}
+type Pool41[TInput any, T1 any, T2 any, T3 any] struct{}
 
 func NewWorkerPool41[TInput any, T1 any, T2 any, T3 any]() *Pool41[TInput, T1, T2, T3] {
-	return &Pool41[TInput, T1, T2, T3]{
-		Pool: pool.New().WithMaxGoroutines(3),
-	}
+	return &Pool41[TInput, T1, T2, T3]{}
 }
 
 func (r *Pool41[TInput, T1, T2, T3]) Zip(
@@ -70,44 +114,9 @@ func (r *Pool41[TInput, T1, T2, T3]) Zip(
 	f2 func(elements []TInput) ([]T2, error),
 	f3 func(elements []TInput) ([]T3, error),
 	f func(r1 []T1, r2 []T2, r3 []T3, err error) ([]T1, error)) ([]T1, error) {
-	var aggErr error
-
-	var r1 []T1
-	r.Pool.Go(func() {
-		c1, err := f1(elements)
-		if err != nil {
-			aggErr = multierr.Append(aggErr, err)
-			return
-		}
-		r1 = append(r1, c1...)
-	})
-
-	var r2 []T2
-	r.Pool.Go(func() {
-		c2, err := f2(elements)
-		if err != nil {
-			aggErr = multierr.Append(aggErr, err)
-			return
-		}
-		r2 = append(r2, c2...)
-	})
-
-	var r3 []T3
-	r.Pool.Go(func() {
-		c3, err := f3(elements)
-		if err != nil {
-			aggErr = multierr.Append(aggErr, err)
-			return
-		}
-		r3 = append(r3, c3...)
-	})
-
-	r.Pool.Wait()
-
-	result, err := f(r1, r2, r3, aggErr)
-	if err != nil {
-		return nil, err
-	}
+	s1, s2, s3 := NewStage(f1), NewStage(f2), NewStage(f3)
 
-	return result, aggErr
+	return Zip(elements, func(err error) ([]T1, error) {
+		return f(s1.Results(), s2.Results(), s3.Results(), err)
+	}, s1, s2, s3)
 }
@@ -0,0 +1,149 @@
+package tpl
+
+import (
+	"context"
+
+	"github.com/sourcegraph/conc/pool"
+)
+
+type Stage[TInput any] interface {
+	run(ctx context.Context, elements []TInput, failFast bool) error
+}
+
+// StageOf runs f over the zipped input, split into up to maxGoroutines chunks
+// processed concurrently. Results keep the order of the chunks and are those of
+// the last run.
+type StageOf[TInput any, T any] struct {
+	name          string
+	f             func(ctx context.Context, elements []TInput) ([]T, error)
+	maxGoroutines int
+	results       []T
+}
+
+func NewStage[TInput any, T any](f func(elements []TInput) ([]T, error), maxGoroutines ...int) *StageOf[TInput, T] {
+	return newStage(taskName(f), func(_ context.Context, elements []TInput) ([]T, error) {
+		return f(elements)
+	}, maxGoroutines...)
+}
+
+func NewStageContext[TInput any, T any](f func(ctx context.Context, elements []TInput) ([]T, error), maxGoroutines ...int) *StageOf[TInput, T] {
+	return newStage(taskName(f), f, maxGoroutines...)
+}
+
+func newStage[TInput any, T any](name string, f func(ctx context.Context, elements []TInput) ([]T, error), maxGoroutines ...int) *StageOf[TInput, T] {
+	stage := &StageOf[TInput, T]{
+		name:          name,
+		f:             f,
+		maxGoroutines: 1,
+	}
+
+	if len(maxGoroutines) > 0 && maxGoroutines[0] > 0 {
+		stage.maxGoroutines = maxGoroutines[0]
+	}
+
+	return stage
+}
+
+func (r *StageOf[TInput, T]) Results() []T {
+	return r.results
+}
+
+func (r *StageOf[TInput, T]) run(ctx context.Context, elements []TInput, failFast bool) error {
+	r.results = nil
+
+	chunks := chunk(elements, r.maxGoroutines)
+	if len(chunks) == 1 {
+		results, err := r.call(ctx, chunks[0])
+		if err != nil {
+			return err
+		}
+		r.results = results
+		return nil
+	}
+
+	g := newGroup(ctx, failFast)
+	chunkResults := make([][]T, len(chunks))
+
+	p := pool.New().WithMaxGoroutines(len(chunks))
+	for i := 0; i < len(chunks) && !g.stopped(); i++ {
+		i := i
+		p.Go(func() {
+			results, err := r.call(g.ctx, chunks[i])
+			if err != nil {
+				g.add(err)
+				return
+			}
+			chunkResults[i] = results
+		})
+	}
+	p.Wait()
+
+	for i := 0; i < len(chunkResults); i++ {
+		r.results = append(r.results, chunkResults[i]...)
+	}
+
+	return g.wait()
+}
+
+func (r *StageOf[TInput, T]) call(ctx context.Context, elements []TInput) ([]T, error) {
+	var results []T
+
+	err := recoverTask(r.name, func() error {
+		var err error
+		results, err = r.f(ctx, elements)
+		return err
+	})
+
+	return results, err
+}
+
+// Zip fans the same elements out to every stage concurrently and, once all of
+// them are done, fans in through combine with the aggregated stage errors.
+func Zip[TInput any, TOutput any](elements []TInput, combine func(err error) (TOutput, error), stages ...Stage[TInput]) (TOutput, error) {
+	return zip(context.Background(), false, elements, combine, stages...)
+}
+
+// ZipFailFast is Zip cancelling ctx for every stage as soon as one of them
+// fails. combine receives a *FailFastError in that case.
+func ZipFailFast[TInput any, TOutput any](ctx context.Context, elements []TInput, combine func(err error) (TOutput, error), stages ...Stage[TInput]) (TOutput, error) {
+	return zip(ctx, true, elements, combine, stages...)
+}
+
+func zip[TInput any, TOutput any](ctx context.Context, failFast bool, elements []TInput, combine func(err error) (TOutput, error), stages ...Stage[TInput]) (TOutput, error) {
+	g := newGroup(ctx, failFast)
+
+	p := pool.New().WithMaxGoroutines(max(len(stages), 1))
+	for _, stage := range stages {
+		stage := stage
+		p.Go(func() {
+			g.add(stage.run(g.ctx, elements, failFast))
+		})
+	}
+	p.Wait()
+
+	aggErr := g.wait()
+
+	result, err := combine(aggErr)
+	if err != nil {
+		var zero TOutput
+		return zero, err
+	}
+
+	return result, aggErr
+}
+
+func chunk[T any](elements []T, n int) [][]T {
+	if n <= 1 || len(elements) <= 1 {
+		return [][]T{elements}
+	}
+
+	n = min(n, len(elements))
+	size := (len(elements) + n - 1) / n
+
+	chunks := make([][]T, 0, n)
+	for start := 0; start < len(elements); start += size {
+		chunks = append(chunks, elements[start:min(start+size, len(elements))])
+	}
+
+	return chunks
+}
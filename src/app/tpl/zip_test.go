@@ -0,0 +1,111 @@
+package tpl_test
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/tpl"
+	"go.uber.org/multierr"
+)
+
+func TestZip(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	var calls atomic.Int32
+
+	squares := tpl.NewStage(func(elements []int) ([]int, error) {
+		calls.Add(1)
+		var results []int
+		for _, e := range elements {
+			results = append(results, e*e)
+		}
+		return results, nil
+	}, 4)
+
+	strings := tpl.NewStage(func(elements []int) ([]string, error) {
+		var results []string
+		for _, e := range elements {
+			results = append(results, strconv.Itoa(e))
+		}
+		return results, nil
+	})
+
+	evens := tpl.NewStage(func(elements []int) ([]bool, error) {
+		var results []bool
+		for _, e := range elements {
+			results = append(results, e%2 == 0)
+		}
+		return results, nil
+	}, 3)
+
+	actual, err := tpl.Zip(numbers, func(err error) (map[string]int, error) {
+		require.NoError(t, err)
+
+		result := make(map[string]int)
+		for i, s := range strings.Results() {
+			if evens.Results()[i] {
+				result[s] = squares.Results()[i]
+			}
+		}
+		return result, nil
+	}, squares, strings, evens)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), calls.Load())
+	assert.Equal(t, []int{1, 4, 9, 16, 25, 36, 49, 64, 81, 100}, squares.Results())
+	assert.Equal(t, map[string]int{"2": 4, "4": 16, "6": 36, "8": 64, "10": 100}, actual)
+}
+
+func TestZip_Err(t *testing.T) {
+	numbers := []int{1, 2, 3, 4}
+
+	failing := tpl.NewStage(func(elements []int) ([]int, error) {
+		return nil, errors.New("stage error")
+	}, 2)
+
+	succeeding := tpl.NewStage(func(elements []int) ([]int, error) {
+		return elements, nil
+	})
+
+	actual, err := tpl.Zip(numbers, func(err error) ([]int, error) {
+		require.Error(t, err)
+		return succeeding.Results(), nil
+	}, failing, succeeding)
+
+	require.Error(t, err)
+	assert.Len(t, multierr.Errors(err), 2)
+	assert.Equal(t, numbers, actual)
+	assert.Empty(t, failing.Results())
+}
+
+func TestZip_Reused(t *testing.T) {
+	stage := tpl.NewStage(func(elements []int) ([]int, error) {
+		return elements, nil
+	}, 2)
+
+	for i := 0; i < 2; i++ {
+		_, err := tpl.Zip([]int{1, 2, 3}, func(err error) ([]int, error) {
+			return stage.Results(), nil
+		}, stage)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, stage.Results())
+}
+
+func TestZip_CombineErr(t *testing.T) {
+	stage := tpl.NewStage(func(elements []int) ([]int, error) {
+		return elements, nil
+	})
+
+	actual, err := tpl.Zip([]int{1}, func(err error) ([]int, error) {
+		return nil, errors.New("combine error")
+	}, stage)
+
+	require.EqualError(t, err, "combine error")
+	assert.Nil(t, actual)
+}
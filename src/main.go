@@ -13,11 +13,15 @@ import (
 // @version v1.
 func main() {
 	server := core.NewServer()
+	application := new(app.Application)
 
-	server.On(new(app.Application))
+	server.On(application)
 	server.Start()
 
-	if err := server.Join(); err != nil {
+	err := server.Join()
+	application.Shutdown()
+
+	if err != nil {
 		log.Fatal(err)
 	}
 }
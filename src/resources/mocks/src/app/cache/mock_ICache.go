@@ -0,0 +1,199 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockICache is an autogenerated mock type for the ICache type
+type MockICache struct {
+	mock.Mock
+}
+
+type MockICache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICache) EXPECT() *MockICache_Expecter {
+	return &MockICache_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *MockICache) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockICache_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockICache_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockICache_Expecter) Delete(ctx interface{}, key interface{}) *MockICache_Delete_Call {
+	return &MockICache_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockICache_Delete_Call) Run(run func(ctx context.Context, key string)) *MockICache_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockICache_Delete_Call) Return(_a0 error) *MockICache_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockICache_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockICache_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, key
+func (_m *MockICache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []byte
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, bool, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockICache_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockICache_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockICache_Expecter) Get(ctx interface{}, key interface{}) *MockICache_Get_Call {
+	return &MockICache_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockICache_Get_Call) Run(run func(ctx context.Context, key string)) *MockICache_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockICache_Get_Call) Return(_a0 []byte, _a1 bool, _a2 error) *MockICache_Get_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockICache_Get_Call) RunAndReturn(run func(context.Context, string) ([]byte, bool, error)) *MockICache_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, key, value, ttl
+func (_m *MockICache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ret := _m.Called(ctx, key, value, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, time.Duration) error); ok {
+		r0 = rf(ctx, key, value, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockICache_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockICache_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value []byte
+//   - ttl time.Duration
+func (_e *MockICache_Expecter) Set(ctx interface{}, key interface{}, value interface{}, ttl interface{}) *MockICache_Set_Call {
+	return &MockICache_Set_Call{Call: _e.mock.On("Set", ctx, key, value, ttl)}
+}
+
+func (_c *MockICache_Set_Call) Run(run func(ctx context.Context, key string, value []byte, ttl time.Duration)) *MockICache_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockICache_Set_Call) Return(_a0 error) *MockICache_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockICache_Set_Call) RunAndReturn(run func(context.Context, string, []byte, time.Duration) error) *MockICache_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockICache creates a new instance of MockICache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICache {
+	mock := &MockICache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,164 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package clients
+
+import (
+	context "context"
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+// MockIRateLimiter is an autogenerated mock type for the IRateLimiter type
+type MockIRateLimiter struct {
+	mock.Mock
+}
+
+type MockIRateLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRateLimiter) EXPECT() *MockIRateLimiter_Expecter {
+	return &MockIRateLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Snapshot provides a mock function with no fields
+func (_m *MockIRateLimiter) Snapshot() model.RateLimitDTO {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 model.RateLimitDTO
+	if rf, ok := ret.Get(0).(func() model.RateLimitDTO); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.RateLimitDTO)
+	}
+
+	return r0
+}
+
+// MockIRateLimiter_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type MockIRateLimiter_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+func (_e *MockIRateLimiter_Expecter) Snapshot() *MockIRateLimiter_Snapshot_Call {
+	return &MockIRateLimiter_Snapshot_Call{Call: _e.mock.On("Snapshot")}
+}
+
+func (_c *MockIRateLimiter_Snapshot_Call) Run(run func()) *MockIRateLimiter_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIRateLimiter_Snapshot_Call) Return(_a0 model.RateLimitDTO) *MockIRateLimiter_Snapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRateLimiter_Snapshot_Call) RunAndReturn(run func() model.RateLimitDTO) *MockIRateLimiter_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: status, header
+func (_m *MockIRateLimiter) Update(status int, header http.Header) {
+	_m.Called(status, header)
+}
+
+// MockIRateLimiter_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRateLimiter_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - status int
+//   - header http.Header
+func (_e *MockIRateLimiter_Expecter) Update(status interface{}, header interface{}) *MockIRateLimiter_Update_Call {
+	return &MockIRateLimiter_Update_Call{Call: _e.mock.On("Update", status, header)}
+}
+
+func (_c *MockIRateLimiter_Update_Call) Run(run func(status int, header http.Header)) *MockIRateLimiter_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(http.Header))
+	})
+	return _c
+}
+
+func (_c *MockIRateLimiter_Update_Call) Return() *MockIRateLimiter_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIRateLimiter_Update_Call) RunAndReturn(run func(int, http.Header)) *MockIRateLimiter_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Wait provides a mock function with given fields: ctx
+func (_m *MockIRateLimiter) Wait(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRateLimiter_Wait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wait'
+type MockIRateLimiter_Wait_Call struct {
+	*mock.Call
+}
+
+// Wait is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIRateLimiter_Expecter) Wait(ctx interface{}) *MockIRateLimiter_Wait_Call {
+	return &MockIRateLimiter_Wait_Call{Call: _e.mock.On("Wait", ctx)}
+}
+
+func (_c *MockIRateLimiter_Wait_Call) Run(run func(ctx context.Context)) *MockIRateLimiter_Wait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIRateLimiter_Wait_Call) Return(_a0 error) *MockIRateLimiter_Wait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRateLimiter_Wait_Call) RunAndReturn(run func(context.Context) error) *MockIRateLimiter_Wait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIRateLimiter creates a new instance of MockIRateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRateLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRateLimiter {
+	mock := &MockIRateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
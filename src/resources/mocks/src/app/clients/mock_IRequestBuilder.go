@@ -0,0 +1,86 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package clients
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	rest "gitlab.com/iskaypetcom/digital/sre/tools/dev/go-restclient/rest"
+)
+
+// MockIRequestBuilder is an autogenerated mock type for the IRequestBuilder type
+type MockIRequestBuilder struct {
+	mock.Mock
+}
+
+type MockIRequestBuilder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRequestBuilder) EXPECT() *MockIRequestBuilder_Expecter {
+	return &MockIRequestBuilder_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, url
+func (_m *MockIRequestBuilder) Get(ctx context.Context, url string) *rest.Response {
+	ret := _m.Called(ctx, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *rest.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *rest.Response); ok {
+		r0 = rf(ctx, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rest.Response)
+		}
+	}
+
+	return r0
+}
+
+// MockIRequestBuilder_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIRequestBuilder_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+func (_e *MockIRequestBuilder_Expecter) Get(ctx interface{}, url interface{}) *MockIRequestBuilder_Get_Call {
+	return &MockIRequestBuilder_Get_Call{Call: _e.mock.On("Get", ctx, url)}
+}
+
+func (_c *MockIRequestBuilder_Get_Call) Run(run func(ctx context.Context, url string)) *MockIRequestBuilder_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIRequestBuilder_Get_Call) Return(_a0 *rest.Response) *MockIRequestBuilder_Get_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRequestBuilder_Get_Call) RunAndReturn(run func(context.Context, string) *rest.Response) *MockIRequestBuilder_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIRequestBuilder creates a new instance of MockIRequestBuilder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRequestBuilder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRequestBuilder {
+	mock := &MockIRequestBuilder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
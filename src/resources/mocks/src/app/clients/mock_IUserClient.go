@@ -3,8 +3,11 @@
 package clients
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+
 	paging "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
 )
 
@@ -21,9 +24,248 @@ func (_m *MockIUserClient) EXPECT() *MockIUserClient_Expecter {
 	return &MockIUserClient_Expecter{mock: &_m.Mock}
 }
 
-// GetComments provides a mock function with given fields: postID
-func (_m *MockIUserClient) GetComments(postID int) ([]model.CommentResponse, error) {
-	ret := _m.Called(postID)
+// GetAllComments provides a mock function with given fields: ctx, page, perPage
+func (_m *MockIUserClient) GetAllComments(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	ret := _m.Called(ctx, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllComments")
+	}
+
+	var r0 *paging.PagedResultResponse[model.CommentResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*paging.PagedResultResponse[model.CommentResponse], error)); ok {
+		return rf(ctx, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *paging.PagedResultResponse[model.CommentResponse]); ok {
+		r0 = rf(ctx, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*paging.PagedResultResponse[model.CommentResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserClient_GetAllComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllComments'
+type MockIUserClient_GetAllComments_Call struct {
+	*mock.Call
+}
+
+// GetAllComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - perPage int
+func (_e *MockIUserClient_Expecter) GetAllComments(ctx interface{}, page interface{}, perPage interface{}) *MockIUserClient_GetAllComments_Call {
+	return &MockIUserClient_GetAllComments_Call{Call: _e.mock.On("GetAllComments", ctx, page, perPage)}
+}
+
+func (_c *MockIUserClient_GetAllComments_Call) Run(run func(ctx context.Context, page int, perPage int)) *MockIUserClient_GetAllComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserClient_GetAllComments_Call) Return(_a0 *paging.PagedResultResponse[model.CommentResponse], _a1 error) *MockIUserClient_GetAllComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserClient_GetAllComments_Call) RunAndReturn(run func(context.Context, int, int) (*paging.PagedResultResponse[model.CommentResponse], error)) *MockIUserClient_GetAllComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllPosts provides a mock function with given fields: ctx, page, perPage
+func (_m *MockIUserClient) GetAllPosts(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.PostResponse], error) {
+	ret := _m.Called(ctx, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPosts")
+	}
+
+	var r0 *paging.PagedResultResponse[model.PostResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*paging.PagedResultResponse[model.PostResponse], error)); ok {
+		return rf(ctx, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *paging.PagedResultResponse[model.PostResponse]); ok {
+		r0 = rf(ctx, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*paging.PagedResultResponse[model.PostResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserClient_GetAllPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPosts'
+type MockIUserClient_GetAllPosts_Call struct {
+	*mock.Call
+}
+
+// GetAllPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - perPage int
+func (_e *MockIUserClient_Expecter) GetAllPosts(ctx interface{}, page interface{}, perPage interface{}) *MockIUserClient_GetAllPosts_Call {
+	return &MockIUserClient_GetAllPosts_Call{Call: _e.mock.On("GetAllPosts", ctx, page, perPage)}
+}
+
+func (_c *MockIUserClient_GetAllPosts_Call) Run(run func(ctx context.Context, page int, perPage int)) *MockIUserClient_GetAllPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserClient_GetAllPosts_Call) Return(_a0 *paging.PagedResultResponse[model.PostResponse], _a1 error) *MockIUserClient_GetAllPosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserClient_GetAllPosts_Call) RunAndReturn(run func(context.Context, int, int) (*paging.PagedResultResponse[model.PostResponse], error)) *MockIUserClient_GetAllPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllTodos provides a mock function with given fields: ctx, page, perPage
+func (_m *MockIUserClient) GetAllTodos(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.TodoResponse], error) {
+	ret := _m.Called(ctx, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTodos")
+	}
+
+	var r0 *paging.PagedResultResponse[model.TodoResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*paging.PagedResultResponse[model.TodoResponse], error)); ok {
+		return rf(ctx, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *paging.PagedResultResponse[model.TodoResponse]); ok {
+		r0 = rf(ctx, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*paging.PagedResultResponse[model.TodoResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserClient_GetAllTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTodos'
+type MockIUserClient_GetAllTodos_Call struct {
+	*mock.Call
+}
+
+// GetAllTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - perPage int
+func (_e *MockIUserClient_Expecter) GetAllTodos(ctx interface{}, page interface{}, perPage interface{}) *MockIUserClient_GetAllTodos_Call {
+	return &MockIUserClient_GetAllTodos_Call{Call: _e.mock.On("GetAllTodos", ctx, page, perPage)}
+}
+
+func (_c *MockIUserClient_GetAllTodos_Call) Run(run func(ctx context.Context, page int, perPage int)) *MockIUserClient_GetAllTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserClient_GetAllTodos_Call) Return(_a0 *paging.PagedResultResponse[model.TodoResponse], _a1 error) *MockIUserClient_GetAllTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserClient_GetAllTodos_Call) RunAndReturn(run func(context.Context, int, int) (*paging.PagedResultResponse[model.TodoResponse], error)) *MockIUserClient_GetAllTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComment provides a mock function with given fields: ctx, commentID
+func (_m *MockIUserClient) GetComment(ctx context.Context, commentID int) (*model.CommentResponse, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComment")
+	}
+
+	var r0 *model.CommentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.CommentResponse, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.CommentResponse); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CommentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserClient_GetComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComment'
+type MockIUserClient_GetComment_Call struct {
+	*mock.Call
+}
+
+// GetComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int
+func (_e *MockIUserClient_Expecter) GetComment(ctx interface{}, commentID interface{}) *MockIUserClient_GetComment_Call {
+	return &MockIUserClient_GetComment_Call{Call: _e.mock.On("GetComment", ctx, commentID)}
+}
+
+func (_c *MockIUserClient_GetComment_Call) Run(run func(ctx context.Context, commentID int)) *MockIUserClient_GetComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserClient_GetComment_Call) Return(_a0 *model.CommentResponse, _a1 error) *MockIUserClient_GetComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserClient_GetComment_Call) RunAndReturn(run func(context.Context, int) (*model.CommentResponse, error)) *MockIUserClient_GetComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComments provides a mock function with given fields: ctx, postID
+func (_m *MockIUserClient) GetComments(ctx context.Context, postID int) ([]model.CommentResponse, error) {
+	ret := _m.Called(ctx, postID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetComments")
@@ -31,19 +273,19 @@ func (_m *MockIUserClient) GetComments(postID int) ([]model.CommentResponse, err
 
 	var r0 []model.CommentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.CommentResponse, error)); ok {
-		return rf(postID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.CommentResponse, error)); ok {
+		return rf(ctx, postID)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.CommentResponse); ok {
-		r0 = rf(postID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.CommentResponse); ok {
+		r0 = rf(ctx, postID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.CommentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(postID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, postID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,14 +299,15 @@ type MockIUserClient_GetComments_Call struct {
 }
 
 // GetComments is a helper method to define mock.On call
+//   - ctx context.Context
 //   - postID int
-func (_e *MockIUserClient_Expecter) GetComments(postID interface{}) *MockIUserClient_GetComments_Call {
-	return &MockIUserClient_GetComments_Call{Call: _e.mock.On("GetComments", postID)}
+func (_e *MockIUserClient_Expecter) GetComments(ctx interface{}, postID interface{}) *MockIUserClient_GetComments_Call {
+	return &MockIUserClient_GetComments_Call{Call: _e.mock.On("GetComments", ctx, postID)}
 }
 
-func (_c *MockIUserClient_GetComments_Call) Run(run func(postID int)) *MockIUserClient_GetComments_Call {
+func (_c *MockIUserClient_GetComments_Call) Run(run func(ctx context.Context, postID int)) *MockIUserClient_GetComments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
@@ -74,14 +317,134 @@ func (_c *MockIUserClient_GetComments_Call) Return(_a0 []model.CommentResponse,
 	return _c
 }
 
-func (_c *MockIUserClient_GetComments_Call) RunAndReturn(run func(int) ([]model.CommentResponse, error)) *MockIUserClient_GetComments_Call {
+func (_c *MockIUserClient_GetComments_Call) RunAndReturn(run func(context.Context, int) ([]model.CommentResponse, error)) *MockIUserClient_GetComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommentsByEmail provides a mock function with given fields: ctx, email, page, perPage
+func (_m *MockIUserClient) GetCommentsByEmail(ctx context.Context, email string, page int, perPage int) (*paging.PagedResultResponse[model.CommentResponse], error) {
+	ret := _m.Called(ctx, email, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentsByEmail")
+	}
+
+	var r0 *paging.PagedResultResponse[model.CommentResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (*paging.PagedResultResponse[model.CommentResponse], error)); ok {
+		return rf(ctx, email, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) *paging.PagedResultResponse[model.CommentResponse]); ok {
+		r0 = rf(ctx, email, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*paging.PagedResultResponse[model.CommentResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, email, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserClient_GetCommentsByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentsByEmail'
+type MockIUserClient_GetCommentsByEmail_Call struct {
+	*mock.Call
+}
+
+// GetCommentsByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - page int
+//   - perPage int
+func (_e *MockIUserClient_Expecter) GetCommentsByEmail(ctx interface{}, email interface{}, page interface{}, perPage interface{}) *MockIUserClient_GetCommentsByEmail_Call {
+	return &MockIUserClient_GetCommentsByEmail_Call{Call: _e.mock.On("GetCommentsByEmail", ctx, email, page, perPage)}
+}
+
+func (_c *MockIUserClient_GetCommentsByEmail_Call) Run(run func(ctx context.Context, email string, page int, perPage int)) *MockIUserClient_GetCommentsByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserClient_GetCommentsByEmail_Call) Return(_a0 *paging.PagedResultResponse[model.CommentResponse], _a1 error) *MockIUserClient_GetCommentsByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserClient_GetCommentsByEmail_Call) RunAndReturn(run func(context.Context, string, int, int) (*paging.PagedResultResponse[model.CommentResponse], error)) *MockIUserClient_GetCommentsByEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPosts provides a mock function with given fields: userID
-func (_m *MockIUserClient) GetPosts(userID int) ([]model.PostResponse, error) {
-	ret := _m.Called(userID)
+// GetPost provides a mock function with given fields: ctx, postID
+func (_m *MockIUserClient) GetPost(ctx context.Context, postID int) (*model.PostResponse, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPost")
+	}
+
+	var r0 *model.PostResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.PostResponse, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.PostResponse); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserClient_GetPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPost'
+type MockIUserClient_GetPost_Call struct {
+	*mock.Call
+}
+
+// GetPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+func (_e *MockIUserClient_Expecter) GetPost(ctx interface{}, postID interface{}) *MockIUserClient_GetPost_Call {
+	return &MockIUserClient_GetPost_Call{Call: _e.mock.On("GetPost", ctx, postID)}
+}
+
+func (_c *MockIUserClient_GetPost_Call) Run(run func(ctx context.Context, postID int)) *MockIUserClient_GetPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserClient_GetPost_Call) Return(_a0 *model.PostResponse, _a1 error) *MockIUserClient_GetPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserClient_GetPost_Call) RunAndReturn(run func(context.Context, int) (*model.PostResponse, error)) *MockIUserClient_GetPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPosts provides a mock function with given fields: ctx, userID
+func (_m *MockIUserClient) GetPosts(ctx context.Context, userID int) ([]model.PostResponse, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPosts")
@@ -89,19 +452,19 @@ func (_m *MockIUserClient) GetPosts(userID int) ([]model.PostResponse, error) {
 
 	var r0 []model.PostResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.PostResponse, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.PostResponse, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.PostResponse); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.PostResponse); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PostResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -115,14 +478,15 @@ type MockIUserClient_GetPosts_Call struct {
 }
 
 // GetPosts is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID int
-func (_e *MockIUserClient_Expecter) GetPosts(userID interface{}) *MockIUserClient_GetPosts_Call {
-	return &MockIUserClient_GetPosts_Call{Call: _e.mock.On("GetPosts", userID)}
+func (_e *MockIUserClient_Expecter) GetPosts(ctx interface{}, userID interface{}) *MockIUserClient_GetPosts_Call {
+	return &MockIUserClient_GetPosts_Call{Call: _e.mock.On("GetPosts", ctx, userID)}
 }
 
-func (_c *MockIUserClient_GetPosts_Call) Run(run func(userID int)) *MockIUserClient_GetPosts_Call {
+func (_c *MockIUserClient_GetPosts_Call) Run(run func(ctx context.Context, userID int)) *MockIUserClient_GetPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
@@ -132,14 +496,73 @@ func (_c *MockIUserClient_GetPosts_Call) Return(_a0 []model.PostResponse, _a1 er
 	return _c
 }
 
-func (_c *MockIUserClient_GetPosts_Call) RunAndReturn(run func(int) ([]model.PostResponse, error)) *MockIUserClient_GetPosts_Call {
+func (_c *MockIUserClient_GetPosts_Call) RunAndReturn(run func(context.Context, int) ([]model.PostResponse, error)) *MockIUserClient_GetPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodo provides a mock function with given fields: ctx, todoID
+func (_m *MockIUserClient) GetTodo(ctx context.Context, todoID int) (*model.TodoResponse, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodo")
+	}
+
+	var r0 *model.TodoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.TodoResponse, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.TodoResponse); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TodoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserClient_GetTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodo'
+type MockIUserClient_GetTodo_Call struct {
+	*mock.Call
+}
+
+// GetTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID int
+func (_e *MockIUserClient_Expecter) GetTodo(ctx interface{}, todoID interface{}) *MockIUserClient_GetTodo_Call {
+	return &MockIUserClient_GetTodo_Call{Call: _e.mock.On("GetTodo", ctx, todoID)}
+}
+
+func (_c *MockIUserClient_GetTodo_Call) Run(run func(ctx context.Context, todoID int)) *MockIUserClient_GetTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserClient_GetTodo_Call) Return(_a0 *model.TodoResponse, _a1 error) *MockIUserClient_GetTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserClient_GetTodo_Call) RunAndReturn(run func(context.Context, int) (*model.TodoResponse, error)) *MockIUserClient_GetTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTodos provides a mock function with given fields: userID
-func (_m *MockIUserClient) GetTodos(userID int) ([]model.TodoResponse, error) {
-	ret := _m.Called(userID)
+// GetTodos provides a mock function with given fields: ctx, userID
+func (_m *MockIUserClient) GetTodos(ctx context.Context, userID int) ([]model.TodoResponse, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTodos")
@@ -147,19 +570,19 @@ func (_m *MockIUserClient) GetTodos(userID int) ([]model.TodoResponse, error) {
 
 	var r0 []model.TodoResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.TodoResponse, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.TodoResponse, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.TodoResponse); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.TodoResponse); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.TodoResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,14 +596,15 @@ type MockIUserClient_GetTodos_Call struct {
 }
 
 // GetTodos is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID int
-func (_e *MockIUserClient_Expecter) GetTodos(userID interface{}) *MockIUserClient_GetTodos_Call {
-	return &MockIUserClient_GetTodos_Call{Call: _e.mock.On("GetTodos", userID)}
+func (_e *MockIUserClient_Expecter) GetTodos(ctx interface{}, userID interface{}) *MockIUserClient_GetTodos_Call {
+	return &MockIUserClient_GetTodos_Call{Call: _e.mock.On("GetTodos", ctx, userID)}
 }
 
-func (_c *MockIUserClient_GetTodos_Call) Run(run func(userID int)) *MockIUserClient_GetTodos_Call {
+func (_c *MockIUserClient_GetTodos_Call) Run(run func(ctx context.Context, userID int)) *MockIUserClient_GetTodos_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
@@ -190,14 +614,14 @@ func (_c *MockIUserClient_GetTodos_Call) Return(_a0 []model.TodoResponse, _a1 er
 	return _c
 }
 
-func (_c *MockIUserClient_GetTodos_Call) RunAndReturn(run func(int) ([]model.TodoResponse, error)) *MockIUserClient_GetTodos_Call {
+func (_c *MockIUserClient_GetTodos_Call) RunAndReturn(run func(context.Context, int) ([]model.TodoResponse, error)) *MockIUserClient_GetTodos_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: userID
-func (_m *MockIUserClient) GetUser(userID int) (*model.UserResponse, error) {
-	ret := _m.Called(userID)
+// GetUser provides a mock function with given fields: ctx, userID
+func (_m *MockIUserClient) GetUser(ctx context.Context, userID int) (*model.UserResponse, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUser")
@@ -205,19 +629,19 @@ func (_m *MockIUserClient) GetUser(userID int) (*model.UserResponse, error) {
 
 	var r0 *model.UserResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (*model.UserResponse, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.UserResponse, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(int) *model.UserResponse); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.UserResponse); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.UserResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -231,14 +655,15 @@ type MockIUserClient_GetUser_Call struct {
 }
 
 // GetUser is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID int
-func (_e *MockIUserClient_Expecter) GetUser(userID interface{}) *MockIUserClient_GetUser_Call {
-	return &MockIUserClient_GetUser_Call{Call: _e.mock.On("GetUser", userID)}
+func (_e *MockIUserClient_Expecter) GetUser(ctx interface{}, userID interface{}) *MockIUserClient_GetUser_Call {
+	return &MockIUserClient_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID)}
 }
 
-func (_c *MockIUserClient_GetUser_Call) Run(run func(userID int)) *MockIUserClient_GetUser_Call {
+func (_c *MockIUserClient_GetUser_Call) Run(run func(ctx context.Context, userID int)) *MockIUserClient_GetUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
@@ -248,14 +673,14 @@ func (_c *MockIUserClient_GetUser_Call) Return(_a0 *model.UserResponse, _a1 erro
 	return _c
 }
 
-func (_c *MockIUserClient_GetUser_Call) RunAndReturn(run func(int) (*model.UserResponse, error)) *MockIUserClient_GetUser_Call {
+func (_c *MockIUserClient_GetUser_Call) RunAndReturn(run func(context.Context, int) (*model.UserResponse, error)) *MockIUserClient_GetUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsers provides a mock function with given fields: page, perPage
-func (_m *MockIUserClient) GetUsers(page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error) {
-	ret := _m.Called(page, perPage)
+// GetUsers provides a mock function with given fields: ctx, page, perPage
+func (_m *MockIUserClient) GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultResponse[model.UserResponse], error) {
+	ret := _m.Called(ctx, page, perPage)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsers")
@@ -263,19 +688,19 @@ func (_m *MockIUserClient) GetUsers(page int, perPage int) (*paging.PagedResultR
 
 	var r0 *paging.PagedResultResponse[model.UserResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (*paging.PagedResultResponse[model.UserResponse], error)); ok {
-		return rf(page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*paging.PagedResultResponse[model.UserResponse], error)); ok {
+		return rf(ctx, page, perPage)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) *paging.PagedResultResponse[model.UserResponse]); ok {
-		r0 = rf(page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *paging.PagedResultResponse[model.UserResponse]); ok {
+		r0 = rf(ctx, page, perPage)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*paging.PagedResultResponse[model.UserResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, perPage)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, page, perPage)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -289,15 +714,16 @@ type MockIUserClient_GetUsers_Call struct {
 }
 
 // GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
 //   - page int
 //   - perPage int
-func (_e *MockIUserClient_Expecter) GetUsers(page interface{}, perPage interface{}) *MockIUserClient_GetUsers_Call {
-	return &MockIUserClient_GetUsers_Call{Call: _e.mock.On("GetUsers", page, perPage)}
+func (_e *MockIUserClient_Expecter) GetUsers(ctx interface{}, page interface{}, perPage interface{}) *MockIUserClient_GetUsers_Call {
+	return &MockIUserClient_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, page, perPage)}
 }
 
-func (_c *MockIUserClient_GetUsers_Call) Run(run func(page int, perPage int)) *MockIUserClient_GetUsers_Call {
+func (_c *MockIUserClient_GetUsers_Call) Run(run func(ctx context.Context, page int, perPage int)) *MockIUserClient_GetUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
@@ -307,7 +733,7 @@ func (_c *MockIUserClient_GetUsers_Call) Return(_a0 *paging.PagedResultResponse[
 	return _c
 }
 
-func (_c *MockIUserClient_GetUsers_Call) RunAndReturn(run func(int, int) (*paging.PagedResultResponse[model.UserResponse], error)) *MockIUserClient_GetUsers_Call {
+func (_c *MockIUserClient_GetUsers_Call) RunAndReturn(run func(context.Context, int, int) (*paging.PagedResultResponse[model.UserResponse], error)) *MockIUserClient_GetUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package binding
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockQuerier is an autogenerated mock type for the Querier type
+type MockQuerier struct {
+	mock.Mock
+}
+
+type MockQuerier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
+	return &MockQuerier_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function with given fields: key, defaultValue
+func (_m *MockQuerier) Query(key string, defaultValue ...string) string {
+	_va := make([]interface{}, len(defaultValue))
+	for _i := range defaultValue {
+		_va[_i] = defaultValue[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, ...string) string); ok {
+		r0 = rf(key, defaultValue...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockQuerier_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockQuerier_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - key string
+//   - defaultValue ...string
+func (_e *MockQuerier_Expecter) Query(key interface{}, defaultValue ...interface{}) *MockQuerier_Query_Call {
+	return &MockQuerier_Query_Call{Call: _e.mock.On("Query",
+		append([]interface{}{key}, defaultValue...)...)}
+}
+
+func (_c *MockQuerier_Query_Call) Run(run func(key string, defaultValue ...string)) *MockQuerier_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_Query_Call) Return(_a0 string) *MockQuerier_Query_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_Query_Call) RunAndReturn(run func(string, ...string) string) *MockQuerier_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQuerier creates a new instance of MockQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQuerier {
+	mock := &MockQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package caching
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	caching "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+)
+
+// MockIConditional is an autogenerated mock type for the IConditional type
+type MockIConditional struct {
+	mock.Mock
+}
+
+type MockIConditional_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIConditional) EXPECT() *MockIConditional_Expecter {
+	return &MockIConditional_Expecter{mock: &_m.Mock}
+}
+
+// Evaluate provides a mock function with given fields: policy, request, body
+func (_m *MockIConditional) Evaluate(policy caching.Policy, request caching.Request, body []byte) caching.Result {
+	ret := _m.Called(policy, request, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluate")
+	}
+
+	var r0 caching.Result
+	if rf, ok := ret.Get(0).(func(caching.Policy, caching.Request, []byte) caching.Result); ok {
+		r0 = rf(policy, request, body)
+	} else {
+		r0 = ret.Get(0).(caching.Result)
+	}
+
+	return r0
+}
+
+// MockIConditional_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type MockIConditional_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - policy caching.Policy
+//   - request caching.Request
+//   - body []byte
+func (_e *MockIConditional_Expecter) Evaluate(policy interface{}, request interface{}, body interface{}) *MockIConditional_Evaluate_Call {
+	return &MockIConditional_Evaluate_Call{Call: _e.mock.On("Evaluate", policy, request, body)}
+}
+
+func (_c *MockIConditional_Evaluate_Call) Run(run func(policy caching.Policy, request caching.Request, body []byte)) *MockIConditional_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(caching.Policy), args[1].(caching.Request), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockIConditional_Evaluate_Call) Return(_a0 caching.Result) *MockIConditional_Evaluate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIConditional_Evaluate_Call) RunAndReturn(run func(caching.Policy, caching.Request, []byte) caching.Result) *MockIConditional_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIConditional creates a new instance of MockIConditional. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIConditional(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIConditional {
+	mock := &MockIConditional{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
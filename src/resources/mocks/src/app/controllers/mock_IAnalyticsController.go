@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockIAnalyticsController is an autogenerated mock type for the IAnalyticsController type
+type MockIAnalyticsController struct {
+	mock.Mock
+}
+
+type MockIAnalyticsController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIAnalyticsController) EXPECT() *MockIAnalyticsController_Expecter {
+	return &MockIAnalyticsController_Expecter{mock: &_m.Mock}
+}
+
+// GetAnalytics provides a mock function with given fields: ctx
+func (_m *MockIAnalyticsController) GetAnalytics(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalytics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAnalyticsController_GetAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalytics'
+type MockIAnalyticsController_GetAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetAnalytics is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockIAnalyticsController_Expecter) GetAnalytics(ctx interface{}) *MockIAnalyticsController_GetAnalytics_Call {
+	return &MockIAnalyticsController_GetAnalytics_Call{Call: _e.mock.On("GetAnalytics", ctx)}
+}
+
+func (_c *MockIAnalyticsController_GetAnalytics_Call) Run(run func(ctx *routing.HTTPContext)) *MockIAnalyticsController_GetAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockIAnalyticsController_GetAnalytics_Call) Return(_a0 error) *MockIAnalyticsController_GetAnalytics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAnalyticsController_GetAnalytics_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockIAnalyticsController_GetAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIAnalyticsController creates a new instance of MockIAnalyticsController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIAnalyticsController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIAnalyticsController {
+	mock := &MockIAnalyticsController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockIChangesController is an autogenerated mock type for the IChangesController type
+type MockIChangesController struct {
+	mock.Mock
+}
+
+type MockIChangesController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIChangesController) EXPECT() *MockIChangesController_Expecter {
+	return &MockIChangesController_Expecter{mock: &_m.Mock}
+}
+
+// GetChanges provides a mock function with given fields: ctx
+func (_m *MockIChangesController) GetChanges(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChanges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIChangesController_GetChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChanges'
+type MockIChangesController_GetChanges_Call struct {
+	*mock.Call
+}
+
+// GetChanges is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockIChangesController_Expecter) GetChanges(ctx interface{}) *MockIChangesController_GetChanges_Call {
+	return &MockIChangesController_GetChanges_Call{Call: _e.mock.On("GetChanges", ctx)}
+}
+
+func (_c *MockIChangesController_GetChanges_Call) Run(run func(ctx *routing.HTTPContext)) *MockIChangesController_GetChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockIChangesController_GetChanges_Call) Return(_a0 error) *MockIChangesController_GetChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIChangesController_GetChanges_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockIChangesController_GetChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIChangesController creates a new instance of MockIChangesController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIChangesController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIChangesController {
+	mock := &MockIChangesController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
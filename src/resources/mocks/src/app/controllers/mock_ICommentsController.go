@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockICommentsController is an autogenerated mock type for the ICommentsController type
+type MockICommentsController struct {
+	mock.Mock
+}
+
+type MockICommentsController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICommentsController) EXPECT() *MockICommentsController_Expecter {
+	return &MockICommentsController_Expecter{mock: &_m.Mock}
+}
+
+// GetComments provides a mock function with given fields: ctx
+func (_m *MockICommentsController) GetComments(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockICommentsController_GetComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComments'
+type MockICommentsController_GetComments_Call struct {
+	*mock.Call
+}
+
+// GetComments is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockICommentsController_Expecter) GetComments(ctx interface{}) *MockICommentsController_GetComments_Call {
+	return &MockICommentsController_GetComments_Call{Call: _e.mock.On("GetComments", ctx)}
+}
+
+func (_c *MockICommentsController_GetComments_Call) Run(run func(ctx *routing.HTTPContext)) *MockICommentsController_GetComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockICommentsController_GetComments_Call) Return(_a0 error) *MockICommentsController_GetComments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockICommentsController_GetComments_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockICommentsController_GetComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockICommentsController creates a new instance of MockICommentsController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICommentsController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICommentsController {
+	mock := &MockICommentsController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockIDiagnosticsController is an autogenerated mock type for the IDiagnosticsController type
+type MockIDiagnosticsController struct {
+	mock.Mock
+}
+
+type MockIDiagnosticsController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIDiagnosticsController) EXPECT() *MockIDiagnosticsController_Expecter {
+	return &MockIDiagnosticsController_Expecter{mock: &_m.Mock}
+}
+
+// GetRateLimit provides a mock function with given fields: ctx
+func (_m *MockIDiagnosticsController) GetRateLimit(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRateLimit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIDiagnosticsController_GetRateLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRateLimit'
+type MockIDiagnosticsController_GetRateLimit_Call struct {
+	*mock.Call
+}
+
+// GetRateLimit is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockIDiagnosticsController_Expecter) GetRateLimit(ctx interface{}) *MockIDiagnosticsController_GetRateLimit_Call {
+	return &MockIDiagnosticsController_GetRateLimit_Call{Call: _e.mock.On("GetRateLimit", ctx)}
+}
+
+func (_c *MockIDiagnosticsController_GetRateLimit_Call) Run(run func(ctx *routing.HTTPContext)) *MockIDiagnosticsController_GetRateLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockIDiagnosticsController_GetRateLimit_Call) Return(_a0 error) *MockIDiagnosticsController_GetRateLimit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIDiagnosticsController_GetRateLimit_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockIDiagnosticsController_GetRateLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIDiagnosticsController creates a new instance of MockIDiagnosticsController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIDiagnosticsController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIDiagnosticsController {
+	mock := &MockIDiagnosticsController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
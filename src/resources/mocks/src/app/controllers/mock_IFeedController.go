@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockIFeedController is an autogenerated mock type for the IFeedController type
+type MockIFeedController struct {
+	mock.Mock
+}
+
+type MockIFeedController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIFeedController) EXPECT() *MockIFeedController_Expecter {
+	return &MockIFeedController_Expecter{mock: &_m.Mock}
+}
+
+// GetFeed provides a mock function with given fields: ctx
+func (_m *MockIFeedController) GetFeed(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIFeedController_GetFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeed'
+type MockIFeedController_GetFeed_Call struct {
+	*mock.Call
+}
+
+// GetFeed is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockIFeedController_Expecter) GetFeed(ctx interface{}) *MockIFeedController_GetFeed_Call {
+	return &MockIFeedController_GetFeed_Call{Call: _e.mock.On("GetFeed", ctx)}
+}
+
+func (_c *MockIFeedController_GetFeed_Call) Run(run func(ctx *routing.HTTPContext)) *MockIFeedController_GetFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockIFeedController_GetFeed_Call) Return(_a0 error) *MockIFeedController_GetFeed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIFeedController_GetFeed_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockIFeedController_GetFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIFeedController creates a new instance of MockIFeedController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIFeedController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIFeedController {
+	mock := &MockIFeedController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockIReportsController is an autogenerated mock type for the IReportsController type
+type MockIReportsController struct {
+	mock.Mock
+}
+
+type MockIReportsController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIReportsController) EXPECT() *MockIReportsController_Expecter {
+	return &MockIReportsController_Expecter{mock: &_m.Mock}
+}
+
+// GetOverdueTodos provides a mock function with given fields: ctx
+func (_m *MockIReportsController) GetOverdueTodos(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdueTodos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReportsController_GetOverdueTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOverdueTodos'
+type MockIReportsController_GetOverdueTodos_Call struct {
+	*mock.Call
+}
+
+// GetOverdueTodos is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockIReportsController_Expecter) GetOverdueTodos(ctx interface{}) *MockIReportsController_GetOverdueTodos_Call {
+	return &MockIReportsController_GetOverdueTodos_Call{Call: _e.mock.On("GetOverdueTodos", ctx)}
+}
+
+func (_c *MockIReportsController_GetOverdueTodos_Call) Run(run func(ctx *routing.HTTPContext)) *MockIReportsController_GetOverdueTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockIReportsController_GetOverdueTodos_Call) Return(_a0 error) *MockIReportsController_GetOverdueTodos_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReportsController_GetOverdueTodos_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockIReportsController_GetOverdueTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIReportsController creates a new instance of MockIReportsController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIReportsController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIReportsController {
+	mock := &MockIReportsController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,86 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	caching "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/caching"
+
+	mock "github.com/stretchr/testify/mock"
+
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockIResponder is an autogenerated mock type for the IResponder type
+type MockIResponder struct {
+	mock.Mock
+}
+
+type MockIResponder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIResponder) EXPECT() *MockIResponder_Expecter {
+	return &MockIResponder_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, policy, value
+func (_m *MockIResponder) Send(ctx *routing.HTTPContext, policy caching.Policy, value interface{}) error {
+	ret := _m.Called(ctx, policy, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext, caching.Policy, interface{}) error); ok {
+		r0 = rf(ctx, policy, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIResponder_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockIResponder_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+//   - policy caching.Policy
+//   - value interface{}
+func (_e *MockIResponder_Expecter) Send(ctx interface{}, policy interface{}, value interface{}) *MockIResponder_Send_Call {
+	return &MockIResponder_Send_Call{Call: _e.mock.On("Send", ctx, policy, value)}
+}
+
+func (_c *MockIResponder_Send_Call) Run(run func(ctx *routing.HTTPContext, policy caching.Policy, value interface{})) *MockIResponder_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext), args[1].(caching.Policy), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIResponder_Send_Call) Return(_a0 error) *MockIResponder_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIResponder_Send_Call) RunAndReturn(run func(*routing.HTTPContext, caching.Policy, interface{}) error) *MockIResponder_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIResponder creates a new instance of MockIResponder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIResponder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIResponder {
+	mock := &MockIResponder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
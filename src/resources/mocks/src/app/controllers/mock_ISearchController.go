@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controllers
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	routing "gitlab.com/iskaypetcom/digital/sre/tools/dev/backend-api-sdk/v2/core/routing"
+)
+
+// MockISearchController is an autogenerated mock type for the ISearchController type
+type MockISearchController struct {
+	mock.Mock
+}
+
+type MockISearchController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISearchController) EXPECT() *MockISearchController_Expecter {
+	return &MockISearchController_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function with given fields: ctx
+func (_m *MockISearchController) Search(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockISearchController_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockISearchController_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockISearchController_Expecter) Search(ctx interface{}) *MockISearchController_Search_Call {
+	return &MockISearchController_Search_Call{Call: _e.mock.On("Search", ctx)}
+}
+
+func (_c *MockISearchController_Search_Call) Run(run func(ctx *routing.HTTPContext)) *MockISearchController_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockISearchController_Search_Call) Return(_a0 error) *MockISearchController_Search_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockISearchController_Search_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockISearchController_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockISearchController creates a new instance of MockISearchController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISearchController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISearchController {
+	mock := &MockISearchController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
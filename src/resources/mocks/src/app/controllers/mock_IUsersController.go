@@ -20,6 +20,52 @@ func (_m *MockIUsersController) EXPECT() *MockIUsersController_Expecter {
 	return &MockIUsersController_Expecter{mock: &_m.Mock}
 }
 
+// GetUserStats provides a mock function with given fields: ctx
+func (_m *MockIUsersController) GetUserStats(ctx *routing.HTTPContext) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*routing.HTTPContext) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUsersController_GetUserStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStats'
+type MockIUsersController_GetUserStats_Call struct {
+	*mock.Call
+}
+
+// GetUserStats is a helper method to define mock.On call
+//   - ctx *routing.HTTPContext
+func (_e *MockIUsersController_Expecter) GetUserStats(ctx interface{}) *MockIUsersController_GetUserStats_Call {
+	return &MockIUsersController_GetUserStats_Call{Call: _e.mock.On("GetUserStats", ctx)}
+}
+
+func (_c *MockIUsersController_GetUserStats_Call) Run(run func(ctx *routing.HTTPContext)) *MockIUsersController_GetUserStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*routing.HTTPContext))
+	})
+	return _c
+}
+
+func (_c *MockIUsersController_GetUserStats_Call) Return(_a0 error) *MockIUsersController_GetUserStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUsersController_GetUserStats_Call) RunAndReturn(run func(*routing.HTTPContext) error) *MockIUsersController_GetUserStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUsers provides a mock function with given fields: ctx
 func (_m *MockIUsersController) GetUsers(ctx *routing.HTTPContext) error {
 	ret := _m.Called(ctx)
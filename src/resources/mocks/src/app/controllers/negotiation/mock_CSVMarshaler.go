@@ -0,0 +1,89 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package negotiation
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockCSVMarshaler is an autogenerated mock type for the CSVMarshaler type
+type MockCSVMarshaler struct {
+	mock.Mock
+}
+
+type MockCSVMarshaler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCSVMarshaler) EXPECT() *MockCSVMarshaler_Expecter {
+	return &MockCSVMarshaler_Expecter{mock: &_m.Mock}
+}
+
+// MarshalCSV provides a mock function with no fields
+func (_m *MockCSVMarshaler) MarshalCSV() ([][]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarshalCSV")
+	}
+
+	var r0 [][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([][]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() [][]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCSVMarshaler_MarshalCSV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarshalCSV'
+type MockCSVMarshaler_MarshalCSV_Call struct {
+	*mock.Call
+}
+
+// MarshalCSV is a helper method to define mock.On call
+func (_e *MockCSVMarshaler_Expecter) MarshalCSV() *MockCSVMarshaler_MarshalCSV_Call {
+	return &MockCSVMarshaler_MarshalCSV_Call{Call: _e.mock.On("MarshalCSV")}
+}
+
+func (_c *MockCSVMarshaler_MarshalCSV_Call) Run(run func()) *MockCSVMarshaler_MarshalCSV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCSVMarshaler_MarshalCSV_Call) Return(_a0 [][]string, _a1 error) *MockCSVMarshaler_MarshalCSV_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCSVMarshaler_MarshalCSV_Call) RunAndReturn(run func() ([][]string, error)) *MockCSVMarshaler_MarshalCSV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCSVMarshaler creates a new instance of MockCSVMarshaler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCSVMarshaler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCSVMarshaler {
+	mock := &MockCSVMarshaler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
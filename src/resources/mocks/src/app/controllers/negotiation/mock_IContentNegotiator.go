@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package negotiation
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	negotiation "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/controllers/negotiation"
+)
+
+// MockIContentNegotiator is an autogenerated mock type for the IContentNegotiator type
+type MockIContentNegotiator struct {
+	mock.Mock
+}
+
+type MockIContentNegotiator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIContentNegotiator) EXPECT() *MockIContentNegotiator_Expecter {
+	return &MockIContentNegotiator_Expecter{mock: &_m.Mock}
+}
+
+// Negotiate provides a mock function with given fields: accept, value
+func (_m *MockIContentNegotiator) Negotiate(accept string, value interface{}) (negotiation.ISerializer, error) {
+	ret := _m.Called(accept, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Negotiate")
+	}
+
+	var r0 negotiation.ISerializer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) (negotiation.ISerializer, error)); ok {
+		return rf(accept, value)
+	}
+	if rf, ok := ret.Get(0).(func(string, interface{}) negotiation.ISerializer); ok {
+		r0 = rf(accept, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(negotiation.ISerializer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
+		r1 = rf(accept, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIContentNegotiator_Negotiate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Negotiate'
+type MockIContentNegotiator_Negotiate_Call struct {
+	*mock.Call
+}
+
+// Negotiate is a helper method to define mock.On call
+//   - accept string
+//   - value interface{}
+func (_e *MockIContentNegotiator_Expecter) Negotiate(accept interface{}, value interface{}) *MockIContentNegotiator_Negotiate_Call {
+	return &MockIContentNegotiator_Negotiate_Call{Call: _e.mock.On("Negotiate", accept, value)}
+}
+
+func (_c *MockIContentNegotiator_Negotiate_Call) Run(run func(accept string, value interface{})) *MockIContentNegotiator_Negotiate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIContentNegotiator_Negotiate_Call) Return(_a0 negotiation.ISerializer, _a1 error) *MockIContentNegotiator_Negotiate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIContentNegotiator_Negotiate_Call) RunAndReturn(run func(string, interface{}) (negotiation.ISerializer, error)) *MockIContentNegotiator_Negotiate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIContentNegotiator creates a new instance of MockIContentNegotiator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIContentNegotiator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIContentNegotiator {
+	mock := &MockIContentNegotiator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
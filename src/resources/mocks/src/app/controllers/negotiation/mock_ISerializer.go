@@ -0,0 +1,181 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package negotiation
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockISerializer is an autogenerated mock type for the ISerializer type
+type MockISerializer struct {
+	mock.Mock
+}
+
+type MockISerializer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISerializer) EXPECT() *MockISerializer_Expecter {
+	return &MockISerializer_Expecter{mock: &_m.Mock}
+}
+
+// ContentType provides a mock function with no fields
+func (_m *MockISerializer) ContentType() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContentType")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockISerializer_ContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContentType'
+type MockISerializer_ContentType_Call struct {
+	*mock.Call
+}
+
+// ContentType is a helper method to define mock.On call
+func (_e *MockISerializer_Expecter) ContentType() *MockISerializer_ContentType_Call {
+	return &MockISerializer_ContentType_Call{Call: _e.mock.On("ContentType")}
+}
+
+func (_c *MockISerializer_ContentType_Call) Run(run func()) *MockISerializer_ContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockISerializer_ContentType_Call) Return(_a0 string) *MockISerializer_ContentType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockISerializer_ContentType_Call) RunAndReturn(run func() string) *MockISerializer_ContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Serialize provides a mock function with given fields: value
+func (_m *MockISerializer) Serialize(value interface{}) ([]byte, error) {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Serialize")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) ([]byte, error)); ok {
+		return rf(value)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) []byte); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockISerializer_Serialize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Serialize'
+type MockISerializer_Serialize_Call struct {
+	*mock.Call
+}
+
+// Serialize is a helper method to define mock.On call
+//   - value interface{}
+func (_e *MockISerializer_Expecter) Serialize(value interface{}) *MockISerializer_Serialize_Call {
+	return &MockISerializer_Serialize_Call{Call: _e.mock.On("Serialize", value)}
+}
+
+func (_c *MockISerializer_Serialize_Call) Run(run func(value interface{})) *MockISerializer_Serialize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockISerializer_Serialize_Call) Return(_a0 []byte, _a1 error) *MockISerializer_Serialize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockISerializer_Serialize_Call) RunAndReturn(run func(interface{}) ([]byte, error)) *MockISerializer_Serialize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Supports provides a mock function with given fields: value
+func (_m *MockISerializer) Supports(value interface{}) bool {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Supports")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(interface{}) bool); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockISerializer_Supports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Supports'
+type MockISerializer_Supports_Call struct {
+	*mock.Call
+}
+
+// Supports is a helper method to define mock.On call
+//   - value interface{}
+func (_e *MockISerializer_Expecter) Supports(value interface{}) *MockISerializer_Supports_Call {
+	return &MockISerializer_Supports_Call{Call: _e.mock.On("Supports", value)}
+}
+
+func (_c *MockISerializer_Supports_Call) Run(run func(value interface{})) *MockISerializer_Supports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockISerializer_Supports_Call) Return(_a0 bool) *MockISerializer_Supports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockISerializer_Supports_Call) RunAndReturn(run func(interface{}) bool) *MockISerializer_Supports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockISerializer creates a new instance of MockISerializer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISerializer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISerializer {
+	mock := &MockISerializer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mirror
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockISyncer is an autogenerated mock type for the ISyncer type
+type MockISyncer struct {
+	mock.Mock
+}
+
+type MockISyncer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISyncer) EXPECT() *MockISyncer_Expecter {
+	return &MockISyncer_Expecter{mock: &_m.Mock}
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *MockISyncer) Start(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockISyncer_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockISyncer_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockISyncer_Expecter) Start(ctx interface{}) *MockISyncer_Start_Call {
+	return &MockISyncer_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *MockISyncer_Start_Call) Run(run func(ctx context.Context)) *MockISyncer_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockISyncer_Start_Call) Return() *MockISyncer_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockISyncer_Start_Call) RunAndReturn(run func(context.Context)) *MockISyncer_Start_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *MockISyncer) Stop() {
+	_m.Called()
+}
+
+// MockISyncer_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockISyncer_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *MockISyncer_Expecter) Stop() *MockISyncer_Stop_Call {
+	return &MockISyncer_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *MockISyncer_Stop_Call) Run(run func()) *MockISyncer_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockISyncer_Stop_Call) Return() *MockISyncer_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockISyncer_Stop_Call) RunAndReturn(run func()) *MockISyncer_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockISyncer creates a new instance of MockISyncer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISyncer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISyncer {
+	mock := &MockISyncer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,126 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package paging
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockCSVRecorder is an autogenerated mock type for the CSVRecorder type
+type MockCSVRecorder struct {
+	mock.Mock
+}
+
+type MockCSVRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCSVRecorder) EXPECT() *MockCSVRecorder_Expecter {
+	return &MockCSVRecorder_Expecter{mock: &_m.Mock}
+}
+
+// CSVHeader provides a mock function with no fields
+func (_m *MockCSVRecorder) CSVHeader() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CSVHeader")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// MockCSVRecorder_CSVHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CSVHeader'
+type MockCSVRecorder_CSVHeader_Call struct {
+	*mock.Call
+}
+
+// CSVHeader is a helper method to define mock.On call
+func (_e *MockCSVRecorder_Expecter) CSVHeader() *MockCSVRecorder_CSVHeader_Call {
+	return &MockCSVRecorder_CSVHeader_Call{Call: _e.mock.On("CSVHeader")}
+}
+
+func (_c *MockCSVRecorder_CSVHeader_Call) Run(run func()) *MockCSVRecorder_CSVHeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCSVRecorder_CSVHeader_Call) Return(_a0 []string) *MockCSVRecorder_CSVHeader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCSVRecorder_CSVHeader_Call) RunAndReturn(run func() []string) *MockCSVRecorder_CSVHeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CSVRecords provides a mock function with no fields
+func (_m *MockCSVRecorder) CSVRecords() [][]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CSVRecords")
+	}
+
+	var r0 [][]string
+	if rf, ok := ret.Get(0).(func() [][]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]string)
+		}
+	}
+
+	return r0
+}
+
+// MockCSVRecorder_CSVRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CSVRecords'
+type MockCSVRecorder_CSVRecords_Call struct {
+	*mock.Call
+}
+
+// CSVRecords is a helper method to define mock.On call
+func (_e *MockCSVRecorder_Expecter) CSVRecords() *MockCSVRecorder_CSVRecords_Call {
+	return &MockCSVRecorder_CSVRecords_Call{Call: _e.mock.On("CSVRecords")}
+}
+
+func (_c *MockCSVRecorder_CSVRecords_Call) Run(run func()) *MockCSVRecorder_CSVRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCSVRecorder_CSVRecords_Call) Return(_a0 [][]string) *MockCSVRecorder_CSVRecords_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCSVRecorder_CSVRecords_Call) RunAndReturn(run func() [][]string) *MockCSVRecorder_CSVRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCSVRecorder creates a new instance of MockCSVRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCSVRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCSVRecorder {
+	mock := &MockCSVRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,140 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+// MockIAnalyticsService is an autogenerated mock type for the IAnalyticsService type
+type MockIAnalyticsService struct {
+	mock.Mock
+}
+
+type MockIAnalyticsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIAnalyticsService) EXPECT() *MockIAnalyticsService_Expecter {
+	return &MockIAnalyticsService_Expecter{mock: &_m.Mock}
+}
+
+// GetAnalytics provides a mock function with no fields
+func (_m *MockIAnalyticsService) GetAnalytics() (*model.AnalyticsDTO, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalytics")
+	}
+
+	var r0 *model.AnalyticsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*model.AnalyticsDTO, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *model.AnalyticsDTO); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AnalyticsDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAnalyticsService_GetAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalytics'
+type MockIAnalyticsService_GetAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetAnalytics is a helper method to define mock.On call
+func (_e *MockIAnalyticsService_Expecter) GetAnalytics() *MockIAnalyticsService_GetAnalytics_Call {
+	return &MockIAnalyticsService_GetAnalytics_Call{Call: _e.mock.On("GetAnalytics")}
+}
+
+func (_c *MockIAnalyticsService_GetAnalytics_Call) Run(run func()) *MockIAnalyticsService_GetAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIAnalyticsService_GetAnalytics_Call) Return(_a0 *model.AnalyticsDTO, _a1 error) *MockIAnalyticsService_GetAnalytics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAnalyticsService_GetAnalytics_Call) RunAndReturn(run func() (*model.AnalyticsDTO, error)) *MockIAnalyticsService_GetAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *MockIAnalyticsService) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAnalyticsService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockIAnalyticsService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIAnalyticsService_Expecter) Refresh(ctx interface{}) *MockIAnalyticsService_Refresh_Call {
+	return &MockIAnalyticsService_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *MockIAnalyticsService_Refresh_Call) Run(run func(ctx context.Context)) *MockIAnalyticsService_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIAnalyticsService_Refresh_Call) Return(_a0 error) *MockIAnalyticsService_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAnalyticsService_Refresh_Call) RunAndReturn(run func(context.Context) error) *MockIAnalyticsService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIAnalyticsService creates a new instance of MockIAnalyticsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIAnalyticsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIAnalyticsService {
+	mock := &MockIAnalyticsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockICacheWarmer is an autogenerated mock type for the ICacheWarmer type
+type MockICacheWarmer struct {
+	mock.Mock
+}
+
+type MockICacheWarmer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICacheWarmer) EXPECT() *MockICacheWarmer_Expecter {
+	return &MockICacheWarmer_Expecter{mock: &_m.Mock}
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *MockICacheWarmer) Start(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockICacheWarmer_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockICacheWarmer_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockICacheWarmer_Expecter) Start(ctx interface{}) *MockICacheWarmer_Start_Call {
+	return &MockICacheWarmer_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *MockICacheWarmer_Start_Call) Run(run func(ctx context.Context)) *MockICacheWarmer_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockICacheWarmer_Start_Call) Return() *MockICacheWarmer_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockICacheWarmer_Start_Call) RunAndReturn(run func(context.Context)) *MockICacheWarmer_Start_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *MockICacheWarmer) Stop() {
+	_m.Called()
+}
+
+// MockICacheWarmer_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockICacheWarmer_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *MockICacheWarmer_Expecter) Stop() *MockICacheWarmer_Stop_Call {
+	return &MockICacheWarmer_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *MockICacheWarmer_Stop_Call) Run(run func()) *MockICacheWarmer_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockICacheWarmer_Stop_Call) Return() *MockICacheWarmer_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockICacheWarmer_Stop_Call) RunAndReturn(run func()) *MockICacheWarmer_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockICacheWarmer creates a new instance of MockICacheWarmer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICacheWarmer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICacheWarmer {
+	mock := &MockICacheWarmer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
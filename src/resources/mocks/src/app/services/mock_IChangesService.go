@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+
+	time "time"
+)
+
+// MockIChangesService is an autogenerated mock type for the IChangesService type
+type MockIChangesService struct {
+	mock.Mock
+}
+
+type MockIChangesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIChangesService) EXPECT() *MockIChangesService_Expecter {
+	return &MockIChangesService_Expecter{mock: &_m.Mock}
+}
+
+// GetChanges provides a mock function with given fields: since, cursor, limit
+func (_m *MockIChangesService) GetChanges(since time.Time, cursor string, limit int) (*model.ChangesDTO, error) {
+	ret := _m.Called(since, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChanges")
+	}
+
+	var r0 *model.ChangesDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, string, int) (*model.ChangesDTO, error)); ok {
+		return rf(since, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, string, int) *model.ChangesDTO); ok {
+		r0 = rf(since, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChangesDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, string, int) error); ok {
+		r1 = rf(since, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIChangesService_GetChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChanges'
+type MockIChangesService_GetChanges_Call struct {
+	*mock.Call
+}
+
+// GetChanges is a helper method to define mock.On call
+//   - since time.Time
+//   - cursor string
+//   - limit int
+func (_e *MockIChangesService_Expecter) GetChanges(since interface{}, cursor interface{}, limit interface{}) *MockIChangesService_GetChanges_Call {
+	return &MockIChangesService_GetChanges_Call{Call: _e.mock.On("GetChanges", since, cursor, limit)}
+}
+
+func (_c *MockIChangesService_GetChanges_Call) Run(run func(since time.Time, cursor string, limit int)) *MockIChangesService_GetChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIChangesService_GetChanges_Call) Return(_a0 *model.ChangesDTO, _a1 error) *MockIChangesService_GetChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIChangesService_GetChanges_Call) RunAndReturn(run func(time.Time, string, int) (*model.ChangesDTO, error)) *MockIChangesService_GetChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIChangesService creates a new instance of MockIChangesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIChangesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIChangesService {
+	mock := &MockIChangesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
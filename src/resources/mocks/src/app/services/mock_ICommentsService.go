@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+// MockICommentsService is an autogenerated mock type for the ICommentsService type
+type MockICommentsService struct {
+	mock.Mock
+}
+
+type MockICommentsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICommentsService) EXPECT() *MockICommentsService_Expecter {
+	return &MockICommentsService_Expecter{mock: &_m.Mock}
+}
+
+// FindByEmail provides a mock function with given fields: ctx, email
+func (_m *MockICommentsService) FindByEmail(ctx context.Context, email string) (*model.CommentSearchDTO, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByEmail")
+	}
+
+	var r0 *model.CommentSearchDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.CommentSearchDTO, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.CommentSearchDTO); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CommentSearchDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockICommentsService_FindByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByEmail'
+type MockICommentsService_FindByEmail_Call struct {
+	*mock.Call
+}
+
+// FindByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockICommentsService_Expecter) FindByEmail(ctx interface{}, email interface{}) *MockICommentsService_FindByEmail_Call {
+	return &MockICommentsService_FindByEmail_Call{Call: _e.mock.On("FindByEmail", ctx, email)}
+}
+
+func (_c *MockICommentsService_FindByEmail_Call) Run(run func(ctx context.Context, email string)) *MockICommentsService_FindByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockICommentsService_FindByEmail_Call) Return(_a0 *model.CommentSearchDTO, _a1 error) *MockICommentsService_FindByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockICommentsService_FindByEmail_Call) RunAndReturn(run func(context.Context, string) (*model.CommentSearchDTO, error)) *MockICommentsService_FindByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockICommentsService creates a new instance of MockICommentsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICommentsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICommentsService {
+	mock := &MockICommentsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+// MockIFeedService is an autogenerated mock type for the IFeedService type
+type MockIFeedService struct {
+	mock.Mock
+}
+
+type MockIFeedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIFeedService) EXPECT() *MockIFeedService_Expecter {
+	return &MockIFeedService_Expecter{mock: &_m.Mock}
+}
+
+// GetFeed provides a mock function with given fields: ctx, cursor, limit
+func (_m *MockIFeedService) GetFeed(ctx context.Context, cursor model.Cursor, limit int) (*model.FeedDTO, error) {
+	ret := _m.Called(ctx, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeed")
+	}
+
+	var r0 *model.FeedDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cursor, int) (*model.FeedDTO, error)); ok {
+		return rf(ctx, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cursor, int) *model.FeedDTO); ok {
+		r0 = rf(ctx, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FeedDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Cursor, int) error); ok {
+		r1 = rf(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIFeedService_GetFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeed'
+type MockIFeedService_GetFeed_Call struct {
+	*mock.Call
+}
+
+// GetFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor model.Cursor
+//   - limit int
+func (_e *MockIFeedService_Expecter) GetFeed(ctx interface{}, cursor interface{}, limit interface{}) *MockIFeedService_GetFeed_Call {
+	return &MockIFeedService_GetFeed_Call{Call: _e.mock.On("GetFeed", ctx, cursor, limit)}
+}
+
+func (_c *MockIFeedService_GetFeed_Call) Run(run func(ctx context.Context, cursor model.Cursor, limit int)) *MockIFeedService_GetFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Cursor), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIFeedService_GetFeed_Call) Return(_a0 *model.FeedDTO, _a1 error) *MockIFeedService_GetFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIFeedService_GetFeed_Call) RunAndReturn(run func(context.Context, model.Cursor, int) (*model.FeedDTO, error)) *MockIFeedService_GetFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIFeedService creates a new instance of MockIFeedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIFeedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIFeedService {
+	mock := &MockIFeedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
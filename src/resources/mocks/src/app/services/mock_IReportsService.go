@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+// MockIReportsService is an autogenerated mock type for the IReportsService type
+type MockIReportsService struct {
+	mock.Mock
+}
+
+type MockIReportsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIReportsService) EXPECT() *MockIReportsService_Expecter {
+	return &MockIReportsService_Expecter{mock: &_m.Mock}
+}
+
+// GetOverdueTodos provides a mock function with given fields: ctx
+func (_m *MockIReportsService) GetOverdueTodos(ctx context.Context) (*model.OverdueTodosReportDTO, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdueTodos")
+	}
+
+	var r0 *model.OverdueTodosReportDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.OverdueTodosReportDTO, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.OverdueTodosReportDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OverdueTodosReportDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReportsService_GetOverdueTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOverdueTodos'
+type MockIReportsService_GetOverdueTodos_Call struct {
+	*mock.Call
+}
+
+// GetOverdueTodos is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIReportsService_Expecter) GetOverdueTodos(ctx interface{}) *MockIReportsService_GetOverdueTodos_Call {
+	return &MockIReportsService_GetOverdueTodos_Call{Call: _e.mock.On("GetOverdueTodos", ctx)}
+}
+
+func (_c *MockIReportsService_GetOverdueTodos_Call) Run(run func(ctx context.Context)) *MockIReportsService_GetOverdueTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIReportsService_GetOverdueTodos_Call) Return(_a0 *model.OverdueTodosReportDTO, _a1 error) *MockIReportsService_GetOverdueTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReportsService_GetOverdueTodos_Call) RunAndReturn(run func(context.Context) (*model.OverdueTodosReportDTO, error)) *MockIReportsService_GetOverdueTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIReportsService creates a new instance of MockIReportsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIReportsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIReportsService {
+	mock := &MockIReportsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockISearchIndexer is an autogenerated mock type for the ISearchIndexer type
+type MockISearchIndexer struct {
+	mock.Mock
+}
+
+type MockISearchIndexer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISearchIndexer) EXPECT() *MockISearchIndexer_Expecter {
+	return &MockISearchIndexer_Expecter{mock: &_m.Mock}
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *MockISearchIndexer) Start(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockISearchIndexer_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockISearchIndexer_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockISearchIndexer_Expecter) Start(ctx interface{}) *MockISearchIndexer_Start_Call {
+	return &MockISearchIndexer_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *MockISearchIndexer_Start_Call) Run(run func(ctx context.Context)) *MockISearchIndexer_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockISearchIndexer_Start_Call) Return() *MockISearchIndexer_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockISearchIndexer_Start_Call) RunAndReturn(run func(context.Context)) *MockISearchIndexer_Start_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *MockISearchIndexer) Stop() {
+	_m.Called()
+}
+
+// MockISearchIndexer_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockISearchIndexer_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *MockISearchIndexer_Expecter) Stop() *MockISearchIndexer_Stop_Call {
+	return &MockISearchIndexer_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *MockISearchIndexer_Stop_Call) Run(run func()) *MockISearchIndexer_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockISearchIndexer_Stop_Call) Return() *MockISearchIndexer_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockISearchIndexer_Stop_Call) RunAndReturn(run func()) *MockISearchIndexer_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockISearchIndexer creates a new instance of MockISearchIndexer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISearchIndexer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISearchIndexer {
+	mock := &MockISearchIndexer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
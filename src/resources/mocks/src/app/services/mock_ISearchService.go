@@ -0,0 +1,142 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+)
+
+// MockISearchService is an autogenerated mock type for the ISearchService type
+type MockISearchService struct {
+	mock.Mock
+}
+
+type MockISearchService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISearchService) EXPECT() *MockISearchService_Expecter {
+	return &MockISearchService_Expecter{mock: &_m.Mock}
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *MockISearchService) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockISearchService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockISearchService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockISearchService_Expecter) Refresh(ctx interface{}) *MockISearchService_Refresh_Call {
+	return &MockISearchService_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *MockISearchService_Refresh_Call) Run(run func(ctx context.Context)) *MockISearchService_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockISearchService_Refresh_Call) Return(_a0 error) *MockISearchService_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockISearchService_Refresh_Call) RunAndReturn(run func(context.Context) error) *MockISearchService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: query, limit
+func (_m *MockISearchService) Search(query string, limit int) (*model.SearchDTO, error) {
+	ret := _m.Called(query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 *model.SearchDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*model.SearchDTO, error)); ok {
+		return rf(query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *model.SearchDTO); ok {
+		r0 = rf(query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SearchDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockISearchService_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockISearchService_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - query string
+//   - limit int
+func (_e *MockISearchService_Expecter) Search(query interface{}, limit interface{}) *MockISearchService_Search_Call {
+	return &MockISearchService_Search_Call{Call: _e.mock.On("Search", query, limit)}
+}
+
+func (_c *MockISearchService_Search_Call) Run(run func(query string, limit int)) *MockISearchService_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockISearchService_Search_Call) Return(_a0 *model.SearchDTO, _a1 error) *MockISearchService_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockISearchService_Search_Call) RunAndReturn(run func(string, int) (*model.SearchDTO, error)) *MockISearchService_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockISearchService creates a new instance of MockISearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISearchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISearchService {
+	mock := &MockISearchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,8 +3,11 @@
 package services
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	model "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model"
+
 	paging "gitlab.com/iskaypetcom/digital/oms/api-core/gorest-api/src/app/model/paging"
 )
 
@@ -21,9 +24,127 @@ func (_m *MockIUsersService) EXPECT() *MockIUsersService_Expecter {
 	return &MockIUsersService_Expecter{mock: &_m.Mock}
 }
 
-// GetUsers provides a mock function with given fields: page, perPage
-func (_m *MockIUsersService) GetUsers(page int, perPage int) (*paging.PagedResultDTO[model.UserDTO], error) {
-	ret := _m.Called(page, perPage)
+// GetUser provides a mock function with given fields: ctx, userID
+func (_m *MockIUsersService) GetUser(ctx context.Context, userID int) (*model.UserDTO, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *model.UserDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.UserDTO, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.UserDTO); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUsersService_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockIUsersService_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockIUsersService_Expecter) GetUser(ctx interface{}, userID interface{}) *MockIUsersService_GetUser_Call {
+	return &MockIUsersService_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID)}
+}
+
+func (_c *MockIUsersService_GetUser_Call) Run(run func(ctx context.Context, userID int)) *MockIUsersService_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUsersService_GetUser_Call) Return(_a0 *model.UserDTO, _a1 error) *MockIUsersService_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUsersService_GetUser_Call) RunAndReturn(run func(context.Context, int) (*model.UserDTO, error)) *MockIUsersService_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserStats provides a mock function with given fields: ctx, userID
+func (_m *MockIUsersService) GetUserStats(ctx context.Context, userID int) (*model.UserStatsDTO, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserStats")
+	}
+
+	var r0 *model.UserStatsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.UserStatsDTO, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.UserStatsDTO); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserStatsDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUsersService_GetUserStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStats'
+type MockIUsersService_GetUserStats_Call struct {
+	*mock.Call
+}
+
+// GetUserStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockIUsersService_Expecter) GetUserStats(ctx interface{}, userID interface{}) *MockIUsersService_GetUserStats_Call {
+	return &MockIUsersService_GetUserStats_Call{Call: _e.mock.On("GetUserStats", ctx, userID)}
+}
+
+func (_c *MockIUsersService_GetUserStats_Call) Run(run func(ctx context.Context, userID int)) *MockIUsersService_GetUserStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUsersService_GetUserStats_Call) Return(_a0 *model.UserStatsDTO, _a1 error) *MockIUsersService_GetUserStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUsersService_GetUserStats_Call) RunAndReturn(run func(context.Context, int) (*model.UserStatsDTO, error)) *MockIUsersService_GetUserStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: ctx, page, perPage
+func (_m *MockIUsersService) GetUsers(ctx context.Context, page int, perPage int) (*paging.PagedResultDTO[model.UserDTO], error) {
+	ret := _m.Called(ctx, page, perPage)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsers")
@@ -31,19 +152,19 @@ func (_m *MockIUsersService) GetUsers(page int, perPage int) (*paging.PagedResul
 
 	var r0 *paging.PagedResultDTO[model.UserDTO]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (*paging.PagedResultDTO[model.UserDTO], error)); ok {
-		return rf(page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*paging.PagedResultDTO[model.UserDTO], error)); ok {
+		return rf(ctx, page, perPage)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) *paging.PagedResultDTO[model.UserDTO]); ok {
-		r0 = rf(page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *paging.PagedResultDTO[model.UserDTO]); ok {
+		r0 = rf(ctx, page, perPage)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*paging.PagedResultDTO[model.UserDTO])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, perPage)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, page, perPage)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,15 +178,16 @@ type MockIUsersService_GetUsers_Call struct {
 }
 
 // GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
 //   - page int
 //   - perPage int
-func (_e *MockIUsersService_Expecter) GetUsers(page interface{}, perPage interface{}) *MockIUsersService_GetUsers_Call {
-	return &MockIUsersService_GetUsers_Call{Call: _e.mock.On("GetUsers", page, perPage)}
+func (_e *MockIUsersService_Expecter) GetUsers(ctx interface{}, page interface{}, perPage interface{}) *MockIUsersService_GetUsers_Call {
+	return &MockIUsersService_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, page, perPage)}
 }
 
-func (_c *MockIUsersService_GetUsers_Call) Run(run func(page int, perPage int)) *MockIUsersService_GetUsers_Call {
+func (_c *MockIUsersService_GetUsers_Call) Run(run func(ctx context.Context, page int, perPage int)) *MockIUsersService_GetUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
@@ -75,7 +197,115 @@ func (_c *MockIUsersService_GetUsers_Call) Return(_a0 *paging.PagedResultDTO[mod
 	return _c
 }
 
-func (_c *MockIUsersService_GetUsers_Call) RunAndReturn(run func(int, int) (*paging.PagedResultDTO[model.UserDTO], error)) *MockIUsersService_GetUsers_Call {
+func (_c *MockIUsersService_GetUsers_Call) RunAndReturn(run func(context.Context, int, int) (*paging.PagedResultDTO[model.UserDTO], error)) *MockIUsersService_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersStats provides a mock function with given fields: ctx, page, perPage
+func (_m *MockIUsersService) GetUsersStats(ctx context.Context, page int, perPage int) (*paging.PagedResultDTO[model.UserStatsDTO], error) {
+	ret := _m.Called(ctx, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersStats")
+	}
+
+	var r0 *paging.PagedResultDTO[model.UserStatsDTO]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*paging.PagedResultDTO[model.UserStatsDTO], error)); ok {
+		return rf(ctx, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *paging.PagedResultDTO[model.UserStatsDTO]); ok {
+		r0 = rf(ctx, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*paging.PagedResultDTO[model.UserStatsDTO])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUsersService_GetUsersStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersStats'
+type MockIUsersService_GetUsersStats_Call struct {
+	*mock.Call
+}
+
+// GetUsersStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - perPage int
+func (_e *MockIUsersService_Expecter) GetUsersStats(ctx interface{}, page interface{}, perPage interface{}) *MockIUsersService_GetUsersStats_Call {
+	return &MockIUsersService_GetUsersStats_Call{Call: _e.mock.On("GetUsersStats", ctx, page, perPage)}
+}
+
+func (_c *MockIUsersService_GetUsersStats_Call) Run(run func(ctx context.Context, page int, perPage int)) *MockIUsersService_GetUsersStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUsersService_GetUsersStats_Call) Return(_a0 *paging.PagedResultDTO[model.UserStatsDTO], _a1 error) *MockIUsersService_GetUsersStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUsersService_GetUsersStats_Call) RunAndReturn(run func(context.Context, int, int) (*paging.PagedResultDTO[model.UserStatsDTO], error)) *MockIUsersService_GetUsersStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScanUsers provides a mock function with given fields: ctx, perPage, f
+func (_m *MockIUsersService) ScanUsers(ctx context.Context, perPage int, f func([]model.UserDTO) error) error {
+	ret := _m.Called(ctx, perPage, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanUsers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, func([]model.UserDTO) error) error); ok {
+		r0 = rf(ctx, perPage, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUsersService_ScanUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScanUsers'
+type MockIUsersService_ScanUsers_Call struct {
+	*mock.Call
+}
+
+// ScanUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - perPage int
+//   - f func([]model.UserDTO) error
+func (_e *MockIUsersService_Expecter) ScanUsers(ctx interface{}, perPage interface{}, f interface{}) *MockIUsersService_ScanUsers_Call {
+	return &MockIUsersService_ScanUsers_Call{Call: _e.mock.On("ScanUsers", ctx, perPage, f)}
+}
+
+func (_c *MockIUsersService_ScanUsers_Call) Run(run func(ctx context.Context, perPage int, f func([]model.UserDTO) error)) *MockIUsersService_ScanUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(func([]model.UserDTO) error))
+	})
+	return _c
+}
+
+func (_c *MockIUsersService_ScanUsers_Call) Return(_a0 error) *MockIUsersService_ScanUsers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUsersService_ScanUsers_Call) RunAndReturn(run func(context.Context, int, func([]model.UserDTO) error) error) *MockIUsersService_ScanUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }